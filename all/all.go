@@ -0,0 +1,33 @@
+// Package all registers every day's puzzle with the aoc registry. Import it
+// for its side effects:
+//
+//	import _ "github.com/nickshine/adventofcode2022/all"
+package all
+
+import (
+	_ "github.com/nickshine/adventofcode2022/day1"
+	_ "github.com/nickshine/adventofcode2022/day10"
+	_ "github.com/nickshine/adventofcode2022/day11"
+	_ "github.com/nickshine/adventofcode2022/day12"
+	_ "github.com/nickshine/adventofcode2022/day13"
+	_ "github.com/nickshine/adventofcode2022/day14"
+	_ "github.com/nickshine/adventofcode2022/day15"
+	_ "github.com/nickshine/adventofcode2022/day16"
+	_ "github.com/nickshine/adventofcode2022/day17"
+	_ "github.com/nickshine/adventofcode2022/day18"
+	_ "github.com/nickshine/adventofcode2022/day19"
+	_ "github.com/nickshine/adventofcode2022/day2"
+	_ "github.com/nickshine/adventofcode2022/day20"
+	_ "github.com/nickshine/adventofcode2022/day21"
+	_ "github.com/nickshine/adventofcode2022/day22"
+	_ "github.com/nickshine/adventofcode2022/day23"
+	_ "github.com/nickshine/adventofcode2022/day24"
+	_ "github.com/nickshine/adventofcode2022/day25"
+	_ "github.com/nickshine/adventofcode2022/day3"
+	_ "github.com/nickshine/adventofcode2022/day4"
+	_ "github.com/nickshine/adventofcode2022/day5"
+	_ "github.com/nickshine/adventofcode2022/day6"
+	_ "github.com/nickshine/adventofcode2022/day7"
+	_ "github.com/nickshine/adventofcode2022/day8"
+	_ "github.com/nickshine/adventofcode2022/day9"
+)
@@ -0,0 +1,142 @@
+// Package anim records the frames of a simulation, such as sand piling up in
+// day 14 or elves spreading out in day 23, and exports them as an animated
+// GIF, an animated SVG or an asciinema cast.
+package anim
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Frame is one picture of a simulation, as rows of text.
+type Frame []string
+
+// Capture returns the frame that draw writes, such as a day's display
+// function.
+func Capture(draw func(w io.Writer)) Frame {
+	var b bytes.Buffer
+	draw(&b)
+
+	s := strings.TrimRight(b.String(), "\n")
+	if s == "" {
+		return Frame{}
+	}
+	return Frame(strings.Split(s, "\n"))
+}
+
+// size returns the width and height of f in characters.
+func (f Frame) size() (width, height int) {
+	for _, row := range f {
+		if n := utf8.RuneCountInString(row); n > width {
+			width = n
+		}
+	}
+
+	return width, len(f)
+}
+
+// Recorder receives the frames of a simulation.
+type Recorder interface {
+	Frame(f Frame)
+}
+
+// Recordable is a solver whose simulations can be recorded.
+type Recordable interface {
+	// Record has the solver emit frames to r as it solves, or stop if r is
+	// nil.
+	Record(r Recorder)
+}
+
+// Recording is a Recorder that keeps the frames it's sent, for export.
+type Recording struct {
+	// Every keeps only every nth frame sent, for simulations with more
+	// frames than are worth watching. Zero or one keeps them all.
+	Every int
+
+	// Delay is how long each frame is shown. Zero is a tenth of a second.
+	Delay time.Duration
+
+	Frames []Frame
+
+	sent int
+}
+
+// Frame records f, if it is one of the frames kept.
+func (r *Recording) Frame(f Frame) {
+	r.sent++
+	if r.Every > 1 && (r.sent-1)%r.Every != 0 {
+		return
+	}
+
+	r.Frames = append(r.Frames, f)
+}
+
+func (r *Recording) delay() time.Duration {
+	if r.Delay <= 0 {
+		return 100 * time.Millisecond
+	}
+
+	return r.Delay
+}
+
+// size returns the width and height in characters that fits every frame.
+func (r *Recording) size() (width, height int) {
+	for _, f := range r.Frames {
+		w, h := f.size()
+		if w > width {
+			width = w
+		}
+		if h > height {
+			height = h
+		}
+	}
+
+	return width, height
+}
+
+// WriteFile exports the recording to path, as a GIF, an SVG or an asciicast
+// by its extension: .gif, .svg or .cast.
+func (r *Recording) WriteFile(path string) error {
+	write, err := r.exporter(path)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// CheckPath returns the error WriteFile gives for a path it can't export to,
+// so it can be reported before anything is recorded.
+func CheckPath(path string) error {
+	_, err := (*Recording)(nil).exporter(path)
+	return err
+}
+
+// exporter returns the method WriteFile exports r with, by path's extension.
+func (r *Recording) exporter(path string) (func(io.Writer) error, error) {
+	switch ext := filepath.Ext(path); ext {
+	case ".gif":
+		return r.WriteGIF, nil
+	case ".svg":
+		return r.WriteSVG, nil
+	case ".cast":
+		return r.WriteCast, nil
+	default:
+		return nil, fmt.Errorf("can't export a recording as %q; use .gif, .svg or .cast", ext)
+	}
+}
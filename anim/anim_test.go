@@ -0,0 +1,122 @@
+package anim
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image/gif"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func recording() *Recording {
+	r := &Recording{Delay: 250 * time.Millisecond}
+	r.Frame(Frame{"#.", ".#"})
+	r.Frame(Frame{"<o>"})
+	return r
+}
+
+func TestCapture(t *testing.T) {
+	f := Capture(func(w io.Writer) { fmt.Fprint(w, "ab\ncd\n\n") })
+	if want := (Frame{"ab", "cd"}); !reflect.DeepEqual(f, want) {
+		t.Errorf("Capture = %q, want %q", f, want)
+	}
+}
+
+func TestEvery(t *testing.T) {
+	r := &Recording{Every: 3}
+	for i := 0; i < 7; i++ {
+		r.Frame(Frame{fmt.Sprint(i)})
+	}
+	if want := []Frame{{"0"}, {"3"}, {"6"}}; !reflect.DeepEqual(r.Frames, want) {
+		t.Errorf("Frames = %q, want %q", r.Frames, want)
+	}
+}
+
+func TestWriteGIF(t *testing.T) {
+	var b bytes.Buffer
+	if err := recording().WriteGIF(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := gif.DecodeAll(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Image) != 2 {
+		t.Fatalf("GIF has %d frames, want 2", len(g.Image))
+	}
+	if got, want := g.Image[0].Bounds().Dx(), 3*Scale; got != want {
+		t.Errorf("GIF width = %d, want %d", got, want)
+	}
+	if g.Delay[0] != 25 {
+		t.Errorf("GIF delay = %d, want 25", g.Delay[0])
+	}
+	if g.Image[0].ColorIndexAt(0, 0) != 1 || g.Image[0].ColorIndexAt(Scale, 0) != 0 {
+		t.Errorf("GIF first frame doesn't start with a wall then ground")
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	var b bytes.Buffer
+	if err := recording().WriteSVG(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	svg := b.String()
+	for _, want := range []string{`begin="0.25s"`, `&lt;o&gt;`, `fill="freeze"`} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("SVG is missing %s:\n%s", want, svg)
+		}
+	}
+}
+
+func TestWriteCast(t *testing.T) {
+	var b bytes.Buffer
+	if err := recording().WriteCast(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	sc := bufio.NewScanner(&b)
+	sc.Scan()
+	var h castHeader
+	if err := json.Unmarshal(sc.Bytes(), &h); err != nil {
+		t.Fatal(err)
+	}
+	if h != (castHeader{Version: 2, Width: 3, Height: 2}) {
+		t.Errorf("header = %+v, want version 2, 3x2", h)
+	}
+
+	var events [][]any
+	for sc.Scan() {
+		var e []any
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, e)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	if at, out := events[1][0], events[1][2]; at != 0.25 || !strings.HasSuffix(out.(string), "<o>") {
+		t.Errorf("second event = %v, want the second frame at 0.25s", events[1])
+	}
+}
+
+func TestCheckPath(t *testing.T) {
+	for path, ok := range map[string]bool{
+		"day14.gif":  true,
+		"day14.svg":  true,
+		"day14.cast": true,
+		"day14.png":  false,
+		"day14":      false,
+	} {
+		if err := CheckPath(path); (err == nil) != ok {
+			t.Errorf("CheckPath(%q) = %v, want ok %v", path, err, ok)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package anim
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
+
+// castHeader is the first line of an asciicast v2 file.
+type castHeader struct {
+	Version int `json:"version"`
+	Width   int `json:"width"`
+	Height  int `json:"height"`
+}
+
+// WriteCast writes the recording as an asciicast v2 file, for playing back in
+// a terminal with asciinema.
+func (r *Recording) WriteCast(w io.Writer) error {
+	width, height := r.size()
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(castHeader{Version: 2, Width: width, Height: height}); err != nil {
+		return err
+	}
+
+	for i, f := range r.Frames {
+		// clear the screen and draw the frame from the top left
+		out := "\x1b[H\x1b[2J" + strings.Join(f, "\r\n")
+		at := (time.Duration(i) * r.delay()).Seconds()
+		if err := enc.Encode([]any{at, "o", out}); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
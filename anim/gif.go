@@ -0,0 +1,68 @@
+package anim
+
+import (
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+)
+
+// palette colors the characters of a frame in a GIF: index 0 is the
+// background, for spaces and dots, 1 is for walls and rock, and the rest are
+// shared out between any other characters.
+var palette = color.Palette{
+	color.RGBA{0x0f, 0x0f, 0x23, 0xff}, // background
+	color.RGBA{0xcc, 0xcc, 0xcc, 0xff}, // #
+	color.RGBA{0xff, 0xff, 0x66, 0xff},
+	color.RGBA{0x00, 0xcc, 0x00, 0xff},
+	color.RGBA{0x66, 0x99, 0xff, 0xff},
+	color.RGBA{0xff, 0x66, 0x66, 0xff},
+	color.RGBA{0xff, 0x99, 0x33, 0xff},
+	color.RGBA{0xcc, 0x66, 0xff, 0xff},
+	color.RGBA{0x33, 0xcc, 0xcc, 0xff},
+}
+
+func colorIndex(r rune) uint8 {
+	switch r {
+	case ' ', '.':
+		return 0
+	case '#':
+		return 1
+	default:
+		return uint8(2 + int(r)%(len(palette)-2))
+	}
+}
+
+// Scale is the size in pixels of each character of a frame in a GIF.
+const Scale = 4
+
+// WriteGIF writes the recording as an animated GIF, with each character of a
+// frame drawn as a block of color.
+func (r *Recording) WriteGIF(w io.Writer) error {
+	width, height := r.size()
+	bounds := image.Rect(0, 0, max(width, 1)*Scale, max(height, 1)*Scale)
+	delay := int(r.delay().Milliseconds() / 10) // in hundredths of a second
+
+	anim := &gif.GIF{}
+	for _, f := range r.Frames {
+		img := image.NewPaletted(bounds, palette)
+		for y, row := range f {
+			x := 0
+			for _, c := range row {
+				if i := colorIndex(c); i != 0 {
+					for py := 0; py < Scale; py++ {
+						for px := 0; px < Scale; px++ {
+							img.SetColorIndex(x*Scale+px, y*Scale+py, i)
+						}
+					}
+				}
+				x++
+			}
+		}
+
+		anim.Image = append(anim.Image, img)
+		anim.Delay = append(anim.Delay, delay)
+	}
+
+	return gif.EncodeAll(w, anim)
+}
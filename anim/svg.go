@@ -0,0 +1,44 @@
+package anim
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// The size in pixels of a character in an SVG.
+const (
+	charWidth  = 8.4
+	charHeight = 16
+)
+
+// WriteSVG writes the recording as an animated SVG of text, which shows each
+// frame in turn, then stays on the last.
+func (r *Recording) WriteSVG(w io.Writer) error {
+	width, height := r.size()
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%d">`+"\n", float64(width)*charWidth, height*charHeight)
+	fmt.Fprintln(bw, `<style>text{font-family:monospace;font-size:14px;white-space:pre;fill:#cccccc}</style>`)
+	fmt.Fprintln(bw, `<rect width="100%" height="100%" fill="#0f0f23"/>`)
+
+	for i, f := range r.Frames {
+		fill := "remove"
+		if i == len(r.Frames)-1 {
+			fill = "freeze"
+		}
+		fmt.Fprintln(bw, `<g visibility="hidden">`)
+		fmt.Fprintf(bw, `<set attributeName="visibility" to="visible" begin="%gs" dur="%gs" fill="%s"/>`+"\n", (time.Duration(i) * r.delay()).Seconds(), r.delay().Seconds(), fill)
+		for y, row := range f {
+			fmt.Fprintf(bw, `<text x="0" y="%d">`, (y+1)*charHeight-4)
+			xml.EscapeText(bw, []byte(row))
+			fmt.Fprintln(bw, `</text>`)
+		}
+		fmt.Fprintln(bw, `</g>`)
+	}
+
+	fmt.Fprintln(bw, `</svg>`)
+	return bw.Flush()
+}
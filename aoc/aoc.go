@@ -0,0 +1,97 @@
+// Package aoc defines the interface every day's solution implements and the
+// registry the days add themselves to.
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrNoPart is returned when a puzzle has no such part, as with day 25's
+// second star.
+var ErrNoPart = errors.New("puzzle has no such part")
+
+// Solver solves a day's puzzle. Parse is called once with the puzzle input,
+// after which Part1 and Part2 may be called in any order. Parts must not
+// modify the parsed input, so each can be run on its own.
+type Solver interface {
+	Parse(in string) error
+	Part1() (Answer, error)
+	Part2() (Answer, error)
+}
+
+// SolvePart returns the answer to part 1 or 2 of a parsed puzzle.
+func SolvePart(s Solver, part int) (Answer, error) {
+	switch part {
+	case 1:
+		return s.Part1()
+	case 2:
+		return s.Part2()
+	default:
+		return Answer{}, fmt.Errorf("invalid part %d", part)
+	}
+}
+
+// Answer is the answer to one part of a puzzle. Most puzzles answer with a
+// number; a few, such as the crate tops in day 5, answer with text.
+type Answer struct {
+	n    int
+	s    string
+	text bool
+}
+
+// Int returns a numeric answer.
+func Int(n int) Answer {
+	return Answer{n: n}
+}
+
+// Text returns a text answer.
+func Text(s string) Answer {
+	return Answer{s: s, text: true}
+}
+
+// IsText reports whether a is a text answer.
+func (a Answer) IsText() bool {
+	return a.text
+}
+
+// Int returns the value of a numeric answer.
+func (a Answer) Int() int {
+	return a.n
+}
+
+func (a Answer) String() string {
+	if a.text {
+		return a.s
+	}
+
+	return strconv.Itoa(a.n)
+}
+
+// MarshalJSON encodes a numeric answer as a JSON number and a text answer as
+// a JSON string.
+func (a Answer) MarshalJSON() ([]byte, error) {
+	if a.text {
+		return json.Marshal(a.s)
+	}
+
+	return json.Marshal(a.n)
+}
+
+func (a *Answer) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*a = Text(s)
+		return nil
+	}
+
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("answer must be a number or a string: %s", data)
+	}
+
+	*a = Int(n)
+	return nil
+}
@@ -0,0 +1,198 @@
+package aoc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+)
+
+func TestAnswerJSON(t *testing.T) {
+	tests := []struct {
+		answer Answer
+		json   string
+	}{
+		{Int(24000), `24000`},
+		{Int(-3), `-3`},
+		{Text("CMZ"), `"CMZ"`},
+		{Text("##\n  \n"), `"##\n  \n"`},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.answer)
+		if err != nil {
+			t.Fatalf("Marshal(%q): %v", tt.answer, err)
+		}
+		if string(b) != tt.json {
+			t.Errorf("Marshal(%q) = %s, want %s", tt.answer, b, tt.json)
+		}
+
+		var got Answer
+		if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", tt.json, err)
+		}
+		if got != tt.answer {
+			t.Errorf("Unmarshal(%s) = %q, want %q", tt.json, got, tt.answer)
+		}
+	}
+}
+
+func TestParams(t *testing.T) {
+	p := Params{"y": 10, "max": 20}
+	if err := p.Set("y=2000000"); err != nil {
+		t.Fatal(err)
+	}
+	for _, bad := range []string{"y", "y=ten"} {
+		if err := p.Set(bad); err == nil {
+			t.Errorf("Set(%q) succeeded, want error", bad)
+		}
+	}
+	if got, want := p.String(), "max=20,y=2000000"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	merged := p.Merge(Params{"max": 4000000})
+	if merged["max"] != 4000000 || merged["y"] != 2000000 {
+		t.Errorf("Merge = %v", merged)
+	}
+	if p["max"] != 20 {
+		t.Errorf("Merge modified the receiver: %v", p)
+	}
+}
+
+func TestCheckpoint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ck := NewCheckpoint(ctx, 3)
+	for i := 0; i < 5; i++ {
+		if ck.Done() {
+			t.Fatalf("Done before cancel, call %d", i)
+		}
+	}
+
+	cancel()
+	calls := 0
+	for !ck.Done() {
+		calls++
+	}
+	if calls >= 3 {
+		t.Errorf("Done took %d calls to notice the cancel, want fewer than 3", calls)
+	}
+	if !errors.Is(ck.Err(), context.Canceled) {
+		t.Errorf("Err = %v, want context.Canceled", ck.Err())
+	}
+}
+
+func TestPartial(t *testing.T) {
+	if err := Partial(nil); err != nil {
+		t.Errorf("Partial(nil) = %v, want nil", err)
+	}
+
+	err := fmt.Errorf("day 16: %w", Partial(context.DeadlineExceeded))
+	if !IsPartial(err) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("%v: want a partial answer and context.DeadlineExceeded", err)
+	}
+	if IsPartial(context.DeadlineExceeded) {
+		t.Error("IsPartial(context.DeadlineExceeded) = true, want false")
+	}
+}
+
+func TestTracing(t *testing.T) {
+	var b bytes.Buffer
+	h := slog.NewTextHandler(&b, &slog.HandlerOptions{
+		Level: LevelTrace,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	defer SetTracing(nil, nil)
+
+	quiet, traced := Tracer(1), Tracer(2).With("part", 1)
+	quiet.Info("before")
+	if b.Len() != 0 {
+		t.Fatalf("traced before SetTracing: %s", &b)
+	}
+
+	SetTracing(h, map[int]slog.Level{2: slog.LevelDebug})
+	quiet.Info("not traced")
+	traced.Log(context.Background(), LevelTrace, "too fine")
+	traced.Debug("step", "n", 3)
+
+	if got, want := b.String(), "level=DEBUG msg=step day=2 part=1 n=3\n"; got != want {
+		t.Errorf("traced %q, want %q", got, want)
+	}
+}
+
+func TestRegisterYears(t *testing.T) {
+	newSolver := func(Params) Solver { return nil }
+	Register(Puzzle{Day: 3, New: newSolver})
+	Register(Puzzle{Year: 2015, Day: 3, New: newSolver})
+	Register(Puzzle{Year: 2015, Day: 1, New: newSolver})
+
+	if p, ok := Lookup(3); !ok || p.Year != DefaultYear {
+		t.Errorf("Lookup(3) = year %d, %v, want %d, true", p.Year, ok, DefaultYear)
+	}
+	if p, ok := LookupYear(2015, 3); !ok || p.Year != 2015 {
+		t.Errorf("LookupYear(2015, 3) = year %d, %v, want 2015, true", p.Year, ok)
+	}
+	if _, ok := LookupYear(2015, 2); ok {
+		t.Errorf("LookupYear(2015, 2) found an unregistered day")
+	}
+
+	var days []int
+	for _, p := range PuzzlesYear(2015) {
+		days = append(days, p.Day)
+	}
+	if len(days) != 2 || days[0] != 1 || days[1] != 3 {
+		t.Errorf("PuzzlesYear(2015) = days %v, want [1 3]", days)
+	}
+}
+
+func TestSkipped(t *testing.T) {
+	var e Expected
+	if err := json.Unmarshal([]byte(`{"part2": 3472, "skip2": "too slow"}`), &e); err != nil {
+		t.Fatal(err)
+	}
+	if why, ok := e.Skipped(2); !ok || why != "too slow" {
+		t.Errorf("Skipped(2) = %q, %t, want too slow", why, ok)
+	}
+	if _, ok := e.Skipped(1); ok {
+		t.Error("Skipped(1) with no skip1")
+	}
+}
+
+func TestRejects(t *testing.T) {
+	d := &DayAnswers{Wrong: []Attempt{
+		{Part: 1, Answer: Int(100), Hint: HintTooHigh},
+		{Part: 1, Answer: Int(10), Hint: HintTooLow},
+		{Part: 1, Answer: Int(50)},
+		{Part: 2, Answer: Text("ABC")},
+	}}
+	d.Input.SetPart(2, Text("XYZ"))
+
+	tests := []struct {
+		part   int
+		answer Answer
+		ok     bool
+	}{
+		{1, Int(42), true},
+		{1, Int(50), false},
+		{1, Int(100), false},
+		{1, Int(150), false},
+		{1, Int(10), false},
+		{1, Int(3), false},
+		{1, Text("42"), true},
+		{2, Text("XYZ"), true},
+		{2, Text("ABD"), false}, // the answer is known
+	}
+	for _, tt := range tests {
+		if err := d.Rejects(tt.part, tt.answer); (err == nil) != tt.ok {
+			t.Errorf("Rejects(%d, %s) = %v, want ok %v", tt.part, tt.answer, err, tt.ok)
+		}
+	}
+}
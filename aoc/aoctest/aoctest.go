@@ -0,0 +1,191 @@
+// Package aoctest runs a registered puzzle against inputs with known answers,
+// and benchmarks it, for use in each day's tests.
+package aoctest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// Case is an input and the answers expected for it.
+type Case struct {
+	Name  string
+	Input string
+
+	// Params overrides the puzzle's ExampleParams.
+	Params aoc.Params
+
+	// Part1 and Part2 are the expected answers. A nil part is not checked.
+	Part1, Part2 *aoc.Answer
+
+	// Skip, if set, is the reason the case is skipped.
+	Skip string
+}
+
+// Int returns a pointer to a numeric answer, for use in a Case.
+func Int(n int) *aoc.Answer {
+	a := aoc.Int(n)
+	return &a
+}
+
+// Text returns a pointer to a text answer, for use in a Case.
+func Text(s string) *aoc.Answer {
+	a := aoc.Text(s)
+	return &a
+}
+
+// Run solves each case with the puzzle registered for day, checking each part
+// in its own subtest.
+func Run(t *testing.T, day int, cases []Case) {
+	t.Helper()
+	RunYear(t, aoc.DefaultYear, day, cases)
+}
+
+// RunYear is Run for a day of another year than aoc.DefaultYear.
+func RunYear(t *testing.T, year, day int, cases []Case) {
+	t.Helper()
+	p := lookup(t, year, day)
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			if c.Skip != "" {
+				t.Skip(c.Skip)
+			}
+
+			s := p.New(p.ExampleParams.Merge(c.Params))
+			if err := s.Parse(c.Input); err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+
+			for part, want := range []*aoc.Answer{c.Part1, c.Part2} {
+				if want == nil {
+					continue
+				}
+
+				got, err := aoc.SolvePart(s, part+1)
+				if err != nil {
+					t.Errorf("Part%d: %v", part+1, err)
+					continue
+				}
+				if got != *want {
+					t.Errorf("Part%d = %q, want %q", part+1, got, *want)
+				}
+			}
+		})
+	}
+}
+
+// BadInput is invalid puzzle input and where its ParseError should point.
+type BadInput struct {
+	Name         string
+	Input        string
+	Line, Column int
+}
+
+// ParseErrors checks that the puzzle registered for day rejects each input
+// with an aoc.ParseError at the expected line and column.
+func ParseErrors(t *testing.T, day int, inputs []BadInput) {
+	t.Helper()
+	ParseErrorsYear(t, aoc.DefaultYear, day, inputs)
+}
+
+// ParseErrorsYear is ParseErrors for a day of another year than
+// aoc.DefaultYear.
+func ParseErrorsYear(t *testing.T, year, day int, inputs []BadInput) {
+	t.Helper()
+	p := lookup(t, year, day)
+
+	for _, in := range inputs {
+		t.Run(in.Name, func(t *testing.T) {
+			err := p.New(p.ExampleParams).Parse(in.Input)
+
+			var perr *aoc.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parse error = %v, want a ParseError", err)
+			}
+			if perr.Line != in.Line || perr.Column != in.Column {
+				t.Errorf("Parse error at line %d, column %d, want line %d, column %d: %v", perr.Line, perr.Column, in.Line, in.Column, err)
+			}
+		})
+	}
+}
+
+// Fuzz fuzzes the parser of the puzzle registered for day, seeded with its
+// example and any extra seeds. Parse must never panic, only return an error,
+// and a ParseError must point at a line of the input.
+func Fuzz(f *testing.F, day int, seeds ...string) {
+	FuzzYear(f, aoc.DefaultYear, day, seeds...)
+}
+
+// FuzzYear is Fuzz for a day of another year than aoc.DefaultYear.
+func FuzzYear(f *testing.F, year, day int, seeds ...string) {
+	p := lookup(f, year, day)
+
+	f.Add(p.Example)
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, in string) {
+		err := p.New(p.ExampleParams).Parse(in)
+
+		var perr *aoc.ParseError
+		if !errors.As(err, &perr) {
+			return
+		}
+		if n := strings.Count(in, "\n") + 1; perr.Line < 1 || perr.Line > n {
+			t.Errorf("Parse error at line %d of %d: %v", perr.Line, n, err)
+		}
+	})
+}
+
+// Benchmark measures one part of the puzzle registered for day against its
+// embedded input. A part the puzzle doesn't have is skipped.
+func Benchmark(b *testing.B, day, part int) {
+	BenchmarkYear(b, aoc.DefaultYear, day, part)
+}
+
+// BenchmarkYear is Benchmark for a day of another year than aoc.DefaultYear.
+func BenchmarkYear(b *testing.B, year, day, part int) {
+	p := lookup(b, year, day)
+
+	err := BenchmarkPart(b, p, p.Input, p.Params, part)
+	if errors.Is(err, aoc.ErrNoPart) {
+		b.Skip(err)
+	} else if err != nil {
+		b.Fatal(err)
+	}
+}
+
+// BenchmarkPart measures one part of a puzzle against in, which is parsed once
+// outside the timer. It stops at the first error, leaving the caller to
+// decide whether to fail or skip.
+func BenchmarkPart(b *testing.B, p aoc.Puzzle, in string, params aoc.Params, part int) error {
+	s := p.New(params)
+	if err := s.Parse(in); err != nil {
+		return err
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := aoc.SolvePart(s, part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func lookup(tb testing.TB, year, day int) aoc.Puzzle {
+	tb.Helper()
+
+	p, ok := aoc.LookupYear(year, day)
+	if !ok {
+		tb.Fatalf("%d day %d is not registered", year, day)
+	}
+	return p
+}
@@ -0,0 +1,102 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ContextSolver is a Solver whose slow searches, such as the valve tours of
+// day 16 or the robot factories of day 19, can be cut short. When ctx is done
+// before a part finishes, the part returns the best answer it found so far
+// with ctx's error wrapped by Partial, or no answer with ctx's error if it
+// found none.
+type ContextSolver interface {
+	Solver
+	Part1Context(ctx context.Context) (Answer, error)
+	Part2Context(ctx context.Context) (Answer, error)
+}
+
+// SolvePartContext is SolvePart, cut short when ctx is done if s is a
+// ContextSolver. Other solvers are quick enough to run to the end.
+func SolvePartContext(ctx context.Context, s Solver, part int) (Answer, error) {
+	cs, ok := s.(ContextSolver)
+	if !ok {
+		return SolvePart(s, part)
+	}
+
+	switch part {
+	case 1:
+		return cs.Part1Context(ctx)
+	case 2:
+		return cs.Part2Context(ctx)
+	default:
+		return Answer{}, fmt.Errorf("invalid part %d", part)
+	}
+}
+
+// PartialError is the error of a part cut short with an answer that is only
+// the best it found so far, which may be any value, 0 included.
+type PartialError struct {
+	Err error // why the part was cut short
+}
+
+// Partial wraps err, the context's error, to say the answer returned with it
+// is the best found so far. It returns nil if err is nil.
+func Partial(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PartialError{Err: err}
+}
+
+func (e *PartialError) Error() string {
+	return e.Err.Error() + "; the answer is only the best found so far"
+}
+
+func (e *PartialError) Unwrap() error {
+	return e.Err
+}
+
+// IsPartial reports whether err says the answer returned with it is the best
+// found before the part was cut short.
+func IsPartial(err error) bool {
+	var p *PartialError
+	return errors.As(err, &p)
+}
+
+// Checkpoint checks a context for cancellation every so many calls to Done,
+// which is cheap enough for the inner loop of a search.
+type Checkpoint struct {
+	ctx   context.Context
+	every int
+	calls int
+	err   error
+}
+
+// NewCheckpoint returns a Checkpoint that checks ctx every n calls.
+func NewCheckpoint(ctx context.Context, n int) *Checkpoint {
+	return &Checkpoint{ctx: ctx, every: n}
+}
+
+// Done reports whether the context was found to be done. Once it is, Done
+// always returns true.
+func (c *Checkpoint) Done() bool {
+	if c.err != nil {
+		return true
+	}
+
+	c.calls++
+	if c.calls < c.every {
+		return false
+	}
+	c.calls = 0
+	c.err = c.ctx.Err()
+
+	return c.err != nil
+}
+
+// Err returns the context's error once Done has returned true, or nil.
+func (c *Checkpoint) Err() error {
+	return c.err
+}
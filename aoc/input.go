@@ -0,0 +1,65 @@
+package aoc
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+// ReadInput reads a puzzle input from r. Windows line endings are converted so
+// inputs saved on any platform parse the same.
+func ReadInput(r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ReplaceAll(string(data), "\r\n", "\n"), nil
+}
+
+// ReadInputFile reads a puzzle input from the file at path, or from standard
+// input if path is "-".
+func ReadInputFile(path string) (string, error) {
+	if path == "-" {
+		return ReadInput(os.Stdin)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return ReadInput(f)
+}
+
+// Load reads an input from r and parses it with a new solver for the puzzle.
+func (p Puzzle) Load(r io.Reader, params Params) (Solver, error) {
+	in, err := ReadInput(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s := p.New(params)
+	if err := s.Parse(in); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// LoadFile is like Load but reads the input from the file at path, or from
+// standard input if path is "-".
+func (p Puzzle) LoadFile(path string, params Params) (Solver, error) {
+	in, err := ReadInputFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := p.New(params)
+	if err := s.Parse(in); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
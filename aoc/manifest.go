@@ -0,0 +1,165 @@
+package aoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// Expected holds the known answers for one input. A nil part is not yet known.
+type Expected struct {
+	Part1 *Answer `json:"part1,omitempty"`
+	Part2 *Answer `json:"part2,omitempty"`
+
+	// Skip1 and Skip2 say why a part is known to be solved wrong, for a
+	// solution that is only right for the puzzle input. Verifying skips it.
+	Skip1 string `json:"skip1,omitempty"`
+	Skip2 string `json:"skip2,omitempty"`
+}
+
+// Skipped returns why part 1 or 2 is skipped, if it is.
+func (e Expected) Skipped(part int) (string, bool) {
+	var why string
+	switch part {
+	case 1:
+		why = e.Skip1
+	case 2:
+		why = e.Skip2
+	}
+
+	return why, why != ""
+}
+
+// Part returns the expected answer for part 1 or 2, if known.
+func (e Expected) Part(part int) (Answer, bool) {
+	var a *Answer
+	switch part {
+	case 1:
+		a = e.Part1
+	case 2:
+		a = e.Part2
+	}
+
+	if a == nil {
+		return Answer{}, false
+	}
+
+	return *a, true
+}
+
+// SetPart records the expected answer for part 1 or 2.
+func (e *Expected) SetPart(part int, a Answer) {
+	switch part {
+	case 1:
+		e.Part1 = &a
+	case 2:
+		e.Part2 = &a
+	}
+}
+
+// DayAnswers holds the known answers for a day's example and puzzle input.
+type DayAnswers struct {
+	Example Expected `json:"example"`
+	Input   Expected `json:"input"`
+
+	// Wrong are the answers for the puzzle input the site said were wrong.
+	Wrong []Attempt `json:"wrong,omitempty"`
+}
+
+// The hints the site gives about a wrong answer.
+const (
+	HintTooHigh = "too high"
+	HintTooLow  = "too low"
+)
+
+// Attempt is a wrong answer given for one part of the puzzle input.
+type Attempt struct {
+	Part   int    `json:"part"`
+	Answer Answer `json:"answer"`
+	Hint   string `json:"hint,omitempty"` // HintTooHigh, HintTooLow or none
+}
+
+// Rejects returns why a can't be the answer to part of the puzzle input,
+// going by the known answer and the wrong attempts, or nil if it might be.
+func (d *DayAnswers) Rejects(part int, a Answer) error {
+	if want, ok := d.Input.Part(part); ok && want != a {
+		return fmt.Errorf("the answer is known to be %s", want)
+	}
+
+	for _, w := range d.Wrong {
+		switch {
+		case w.Part != part:
+		case w.Answer == a:
+			return fmt.Errorf("%s was already given, and was wrong", a)
+		case a.IsText() || w.Answer.IsText():
+		case w.Hint == HintTooHigh && a.Int() >= w.Answer.Int():
+			return fmt.Errorf("%s is too high, as %s already was", a, w.Answer)
+		case w.Hint == HintTooLow && a.Int() <= w.Answer.Int():
+			return fmt.Errorf("%s is too low, as %s already was", a, w.Answer)
+		}
+	}
+
+	return nil
+}
+
+// Manifest records the known answers for each day, keyed by day.
+type Manifest map[int]*DayAnswers
+
+// MarshalJSON encodes m as a JSON object ordered by day. The encoding/json
+// default orders the keys as strings, putting day 10 before day 2.
+func (m Manifest) MarshalJSON() ([]byte, error) {
+	days := make([]int, 0, len(m))
+	for d := range m {
+		days = append(days, d)
+	}
+	sort.Ints(days)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, d := range days {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		v, err := json.Marshal(m[d])
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(strconv.Quote(strconv.Itoa(d)))
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// ReadManifest reads a manifest from a JSON file.
+func ReadManifest(path string) (Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	m := Manifest{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// WriteFile writes m as indented JSON.
+func (m Manifest) WriteFile(path string) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(m); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
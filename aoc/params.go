@@ -0,0 +1,51 @@
+package aoc
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Params are the named values a solution needs besides its input, such as the
+// row to scan in day 15. They implement flag.Value so they can be set with
+// repeated -p name=value flags.
+type Params map[string]int
+
+func (p Params) String() string {
+	var pairs []string
+	for k, v := range p {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", k, v))
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+func (p Params) Set(s string) error {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return fmt.Errorf("expected name=value, got %q", s)
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", name, err)
+	}
+
+	p[name] = v
+	return nil
+}
+
+// Merge returns a copy of p with overrides applied.
+func (p Params) Merge(overrides Params) Params {
+	out := make(Params, len(p)+len(overrides))
+	for k, v := range p {
+		out[k] = v
+	}
+	for k, v := range overrides {
+		out[k] = v
+	}
+
+	return out
+}
@@ -0,0 +1,163 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseError reports invalid puzzle input, naming where it was found and the
+// offending token.
+type ParseError struct {
+	Line   int    // 1-based line number
+	Column int    // 1-based column of Token within the line, or 0 if unknown
+	Token  string // the offending text, if any
+	Err    error  // what was wrong with it
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "line %d", e.Line)
+	if e.Column > 0 {
+		fmt.Fprintf(&b, ", column %d", e.Column)
+	}
+	if e.Token != "" {
+		fmt.Fprintf(&b, ": %q", e.Token)
+	}
+	fmt.Fprintf(&b, ": %s", e.Err)
+
+	return b.String()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	// ErrEmpty is returned, wrapped in a ParseError, for input with nothing
+	// in it.
+	ErrEmpty = errors.New("empty input")
+
+	// ErrNotNumber is wrapped in a ParseError for a token that should be a
+	// number.
+	ErrNotNumber = errors.New("expected a number")
+)
+
+// Line is one line of puzzle input.
+type Line struct {
+	N    int // 1-based line number
+	Text string
+}
+
+// Lines splits in into lines, ignoring trailing blank lines. Blank lines within
+// the input are kept so the numbering matches the input.
+func Lines(in string) []Line {
+	in = strings.TrimRight(in, "\n")
+	if in == "" {
+		return nil
+	}
+
+	texts := strings.Split(in, "\n")
+	lines := make([]Line, len(texts))
+	for i, t := range texts {
+		lines[i] = Line{N: i + 1, Text: t}
+	}
+
+	return lines
+}
+
+// Blocks splits in into groups of lines separated by blank lines, as in the
+// calorie lists of day 1 or the monkeys of day 11.
+func Blocks(in string) [][]Line {
+	var blocks [][]Line
+	var block []Line
+	for _, l := range Lines(in) {
+		if strings.TrimSpace(l.Text) == "" {
+			if block != nil {
+				blocks = append(blocks, block)
+				block = nil
+			}
+			continue
+		}
+		block = append(block, l)
+	}
+	if block != nil {
+		blocks = append(blocks, block)
+	}
+
+	return blocks
+}
+
+// Error returns a ParseError for token, which is found in l. The column is
+// where token first appears; use ErrorAt for tokens that may repeat.
+func (l Line) Error(token string, err error) *ParseError {
+	col := 0
+	if token != "" {
+		if i := strings.Index(l.Text, token); i >= 0 {
+			col = i + 1
+		}
+	}
+
+	return &ParseError{Line: l.N, Column: col, Token: token, Err: err}
+}
+
+// ErrorAt returns a ParseError for token, which starts at byte offset i of l.
+func (l Line) ErrorAt(i int, token string, err error) *ParseError {
+	return &ParseError{Line: l.N, Column: i + 1, Token: token, Err: err}
+}
+
+// Errorf is like Error but formats the reason.
+func (l Line) Errorf(token, format string, args ...any) *ParseError {
+	return l.Error(token, fmt.Errorf(format, args...))
+}
+
+// Atoi parses token, which is found in l, as an int.
+func (l Line) Atoi(token string) (int, error) {
+	n, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, l.Error(token, ErrNotNumber)
+	}
+
+	return n, nil
+}
+
+// AtoiAt parses token, which starts at byte offset i of l, as an int.
+func (l Line) AtoiAt(i int, token string) (int, error) {
+	n, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, l.ErrorAt(i, token, ErrNotNumber)
+	}
+
+	return n, nil
+}
+
+// Field is a whitespace separated token of a line.
+type Field struct {
+	Offset int // byte offset of Text in the line
+	Text   string
+}
+
+// Fields splits l around runs of spaces, as strings.Fields does, keeping the
+// offset of each field for error reporting.
+func (l Line) Fields() []Field {
+	var fields []Field
+	start := -1
+	for i := 0; i <= len(l.Text); i++ {
+		space := i == len(l.Text) || l.Text[i] == ' ' || l.Text[i] == '\t'
+		switch {
+		case space && start >= 0:
+			fields = append(fields, Field{Offset: start, Text: l.Text[start:i]})
+			start = -1
+		case !space && start < 0:
+			start = i
+		}
+	}
+
+	return fields
+}
+
+// EmptyError returns the error for input with no lines in it.
+func EmptyError() error {
+	return &ParseError{Line: 1, Err: ErrEmpty}
+}
@@ -0,0 +1,58 @@
+package aoc
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	got := Lines("a\n\nb\n\n\n")
+	want := []Line{{1, "a"}, {2, ""}, {3, "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines = %v, want %v", got, want)
+	}
+
+	if got := Lines("\n"); got != nil {
+		t.Errorf("Lines of a blank input = %v, want nil", got)
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	got := Blocks("1\n2\n\n3\n\n\n4\n")
+	want := [][]Line{{{1, "1"}, {2, "2"}}, {{4, "3"}}, {{7, "4"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Blocks = %v, want %v", got, want)
+	}
+}
+
+func TestFields(t *testing.T) {
+	got := Line{1, "  move 1\tfrom  2 "}.Fields()
+	want := []Field{{2, "move"}, {7, "1"}, {9, "from"}, {15, "2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields = %v, want %v", got, want)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	l := Line{3, "2-4,6-x"}
+
+	_, err := l.AtoiAt(6, "x")
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("AtoiAt error = %v, want a ParseError", err)
+	}
+	if !errors.Is(err, ErrNotNumber) {
+		t.Errorf("AtoiAt error does not wrap ErrNotNumber")
+	}
+	if got, want := err.Error(), `line 3, column 7: "x": expected a number`; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	if got, want := l.Error("6", ErrNotNumber).Column, 5; got != want {
+		t.Errorf("Error column = %d, want %d", got, want)
+	}
+	if got, want := EmptyError().Error(), "line 1: empty input"; got != want {
+		t.Errorf("EmptyError() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+package aoc
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// DefaultYear is the year of the puzzles at the top of this module. Other
+// years live in a directory named for the year, such as 2023/day1.
+const DefaultYear = 2022
+
+// Puzzle describes a day's puzzle and how to build its Solver.
+type Puzzle struct {
+	Year int // zero for DefaultYear
+	Day  int
+
+	// Input and Example are the embedded puzzle input and example input. They
+	// are only defaults; any input can be given to Load instead.
+	Input, Example string
+
+	// Params and ExampleParams are the default parameters for Input and
+	// Example respectively.
+	Params, ExampleParams Params
+
+	// New returns a Solver configured with the given parameters.
+	New func(p Params) Solver
+}
+
+type puzzleKey struct{ year, day int }
+
+var (
+	mu       sync.RWMutex
+	registry = map[puzzleKey]Puzzle{}
+)
+
+// Register makes a puzzle available by its year and day. It is meant to be
+// called from the init function of each day's package, and panics if the day
+// is already registered.
+func Register(p Puzzle) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if p.Year == 0 {
+		p.Year = DefaultYear
+	}
+	if p.New == nil {
+		panic(fmt.Sprintf("aoc: Register %d day %d with nil New", p.Year, p.Day))
+	}
+	k := puzzleKey{p.Year, p.Day}
+	if _, dup := registry[k]; dup {
+		panic(fmt.Sprintf("aoc: Register called twice for %d day %d", p.Year, p.Day))
+	}
+
+	registry[k] = p
+}
+
+// Lookup returns the puzzle registered for day of DefaultYear.
+func Lookup(day int) (Puzzle, bool) {
+	return LookupYear(DefaultYear, day)
+}
+
+// LookupYear returns the puzzle registered for day of year.
+func LookupYear(year, day int) (Puzzle, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	p, ok := registry[puzzleKey{year, day}]
+	return p, ok
+}
+
+// Puzzles returns every registered puzzle of DefaultYear, ordered by day.
+func Puzzles() []Puzzle {
+	return PuzzlesYear(DefaultYear)
+}
+
+// PuzzlesYear returns every registered puzzle of year, ordered by day.
+func PuzzlesYear(year int) []Puzzle {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var puzzles []Puzzle
+	for k, p := range registry {
+		if k.year == year {
+			puzzles = append(puzzles, p)
+		}
+	}
+	sort.Slice(puzzles, func(i, j int) bool {
+		return puzzles[i].Day < puzzles[j].Day
+	})
+
+	return puzzles
+}
@@ -0,0 +1,117 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+)
+
+// LevelTrace is a level below slog.LevelDebug, for the innermost steps of a
+// search, such as each state day 19 considers.
+const LevelTrace = slog.LevelDebug - 4
+
+// ParseLevel parses a level name: trace, debug, info, warn or error.
+func ParseLevel(s string) (slog.Level, error) {
+	if strings.EqualFold(s, "trace") {
+		return LevelTrace, nil
+	}
+
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(s)); err != nil {
+		return 0, fmt.Errorf("invalid level %q; want trace, debug, info, warn or error", s)
+	}
+	return l, nil
+}
+
+// LevelName returns the name of l, naming LevelTrace TRACE rather than
+// DEBUG-4.
+func LevelName(l slog.Level) string {
+	if l == LevelTrace {
+		return "TRACE"
+	}
+	return l.String()
+}
+
+// tracing is where the days' traces go, and at which levels.
+type tracing struct {
+	h      slog.Handler
+	levels map[int]slog.Level // by day, or 0 for every other day
+}
+
+var traceTo atomic.Pointer[tracing]
+
+// SetTracing sends each day's traces at or above its level in levels to h.
+// Levels are keyed by day, with day 0 the level for days not listed; days
+// without a level stay quiet. A nil h turns tracing off.
+func SetTracing(h slog.Handler, levels map[int]slog.Level) {
+	if h == nil {
+		traceTo.Store(nil)
+		return
+	}
+
+	traceTo.Store(&tracing{h: h, levels: levels})
+}
+
+// Tracer returns the logger a day writes its debug traces to. It discards
+// everything until SetTracing enables the day, so normal runs stay quiet.
+// Traces in hot loops should check Enabled first, to not even build their
+// arguments when the day isn't traced.
+func Tracer(day int) *slog.Logger {
+	return slog.New(&dayHandler{day: day})
+}
+
+// dayHandler passes a day's records on to the handler given to SetTracing,
+// with the day added, if the day is traced at their level.
+type dayHandler struct {
+	day int
+
+	// with are the WithAttrs and WithGroup calls made on the handler, to
+	// repeat on whichever handler SetTracing gives.
+	with []func(slog.Handler) slog.Handler
+}
+
+func (d *dayHandler) level(t *tracing) (slog.Level, bool) {
+	if l, ok := t.levels[d.day]; ok {
+		return l, true
+	}
+	l, ok := t.levels[0]
+	return l, ok
+}
+
+func (d *dayHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	t := traceTo.Load()
+	if t == nil {
+		return false
+	}
+
+	min, ok := d.level(t)
+	return ok && level >= min && t.h.Enabled(ctx, level)
+}
+
+func (d *dayHandler) Handle(ctx context.Context, r slog.Record) error {
+	t := traceTo.Load()
+	if t == nil {
+		return nil
+	}
+
+	h := t.h.WithAttrs([]slog.Attr{slog.Int("day", d.day)})
+	for _, w := range d.with {
+		h = w(h)
+	}
+	return h.Handle(ctx, r)
+}
+
+func (d *dayHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return d.and(func(h slog.Handler) slog.Handler { return h.WithAttrs(attrs) })
+}
+
+func (d *dayHandler) WithGroup(name string) slog.Handler {
+	return d.and(func(h slog.Handler) slog.Handler { return h.WithGroup(name) })
+}
+
+func (d *dayHandler) and(w func(slog.Handler) slog.Handler) slog.Handler {
+	with := append(d.with[:len(d.with):len(d.with)], w)
+	return &dayHandler{day: d.day, with: with}
+}
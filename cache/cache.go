@@ -0,0 +1,242 @@
+// Package cache stores solved answers on disk, so that slow parts such as
+// day 19 are solved once rather than on every run. An answer is keyed by the
+// puzzle, the part, a SHA-256 hash of the input and its parameters, and a hash
+// of the running executable, so a change to any solver's code, which changes
+// the executable, never gets an answer solved by the old code.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// Key identifies a solved part.
+type Key struct {
+	Year, Day, Part int
+	Input           string
+	Params          aoc.Params
+}
+
+// name returns the file name of k's entry. It starts with the year and day,
+// for Clear to find.
+func (k Key) name() string {
+	h := sha256.New()
+	io.WriteString(h, k.Input)
+	io.WriteString(h, "\x00"+k.Params.String())
+	return fmt.Sprintf("%d-%d-%d-%x.json", k.Year, k.Day, k.Part, h.Sum(nil))
+}
+
+// Entry is a cached outcome of solving a part.
+type Entry struct {
+	Answer  aoc.Answer    `json:"answer"`
+	NoPart  bool          `json:"no_part,omitempty"` // the puzzle has no such part
+	Elapsed time.Duration `json:"elapsed_ns"`        // how long solving took
+	Solved  time.Time     `json:"solved"`
+}
+
+// Store is a cache of answers in a directory, with a subdirectory for each
+// build of the executable. A nil Store caches nothing.
+type Store struct {
+	Dir   string
+	Build string // the hash of the executable the answers are solved by
+}
+
+// DefaultDir returns the directory for the cache in the user's cache
+// directory.
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc", "answers"), nil
+}
+
+// Open returns the store in dir for the running executable.
+func Open(dir string) (*Store, error) {
+	build, err := BuildHash()
+	if err != nil {
+		return nil, fmt.Errorf("can't identify the build to cache answers for: %w", err)
+	}
+
+	return &Store{Dir: dir, Build: build}, nil
+}
+
+var buildHash = sync.OnceValues(func() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Open(exe)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16], nil
+})
+
+// BuildHash returns a hash of the running executable. Go builds are
+// reproducible, so it's the same for every build of the same code, even by go
+// run, and differs when any of it changes.
+func BuildHash() (string, error) {
+	return buildHash()
+}
+
+func (s *Store) path(k Key) string {
+	return filepath.Join(s.Dir, s.Build, k.name())
+}
+
+// Get returns the entry for k, if there is one.
+func (s *Store) Get(k Key) (Entry, bool) {
+	if s == nil {
+		return Entry{}, false
+	}
+
+	b, err := os.ReadFile(s.path(k))
+	if err != nil {
+		return Entry{}, false
+	}
+
+	var e Entry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return Entry{}, false // a damaged entry is just a miss
+	}
+	return e, true
+}
+
+// Put stores e for k.
+func (s *Store) Put(k Key, e Entry) error {
+	if s == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	path := s.path(k)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	// write it whole or not at all, as a concurrent Get may read it
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Clear removes the entries for day of year from every build, or every day
+// of the year if day is 0, or everything if year is 0 too. It returns how many
+// entries it removed.
+func (s *Store) Clear(year, day int) (int, error) {
+	prefix := ""
+	if year != 0 {
+		prefix = fmt.Sprintf("%d-", year)
+		if day != 0 {
+			prefix += fmt.Sprintf("%d-", day)
+		}
+	}
+
+	builds, err := os.ReadDir(s.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, b := range builds {
+		entries, err := os.ReadDir(filepath.Join(s.Dir, b.Name()))
+		if err != nil {
+			return removed, err
+		}
+		for _, e := range entries {
+			if !strings.HasPrefix(e.Name(), prefix) {
+				continue
+			}
+			if err := os.Remove(filepath.Join(s.Dir, b.Name(), e.Name())); err != nil {
+				return removed, err
+			}
+			removed++
+		}
+	}
+
+	return removed, nil
+}
+
+// Prune removes the entries of every build but the running one, which no
+// run of this build can get, and returns how many it removed.
+func (s *Store) Prune() (int, error) {
+	builds, err := os.ReadDir(s.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, b := range builds {
+		if b.Name() == s.Build {
+			continue
+		}
+		entries, _ := os.ReadDir(filepath.Join(s.Dir, b.Name()))
+		if err := os.RemoveAll(filepath.Join(s.Dir, b.Name())); err != nil {
+			return removed, err
+		}
+		removed += len(entries)
+	}
+
+	return removed, nil
+}
+
+// Count returns how many entries there are for the running build and for
+// every other build.
+func (s *Store) Count() (current, other int, err error) {
+	builds, err := os.ReadDir(s.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, 0, nil
+	} else if err != nil {
+		return 0, 0, err
+	}
+
+	for _, b := range builds {
+		entries, err := os.ReadDir(filepath.Join(s.Dir, b.Name()))
+		if err != nil {
+			return 0, 0, err
+		}
+		if b.Name() == s.Build {
+			current += len(entries)
+		} else {
+			other += len(entries)
+		}
+	}
+
+	return current, other, nil
+}
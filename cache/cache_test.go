@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+func TestStore(t *testing.T) {
+	s := &Store{Dir: t.TempDir(), Build: "a"}
+	k := Key{Year: 2022, Day: 10, Part: 2, Input: "noop\n", Params: aoc.Params{"cycles": 240}}
+	want := Entry{Answer: aoc.Text("#..#\n"), Elapsed: time.Millisecond, Solved: time.Date(2022, 12, 10, 5, 0, 0, 0, time.UTC)}
+
+	if _, ok := s.Get(k); ok {
+		t.Fatal("Get found an entry in an empty store")
+	}
+	if err := s.Put(k, want); err != nil {
+		t.Fatal(err)
+	}
+	got, ok := s.Get(k)
+	if !ok || got.Answer != want.Answer || got.Elapsed != want.Elapsed || !got.Solved.Equal(want.Solved) {
+		t.Fatalf("Get = %+v, %t, want %+v", got, ok, want)
+	}
+
+	for name, other := range map[string]Key{
+		"input":  {Year: 2022, Day: 10, Part: 2, Input: "noop\nnoop\n", Params: k.Params},
+		"params": {Year: 2022, Day: 10, Part: 2, Input: k.Input, Params: aoc.Params{"cycles": 220}},
+		"part":   {Year: 2022, Day: 10, Part: 1, Input: k.Input, Params: k.Params},
+		"year":   {Year: 2021, Day: 10, Part: 2, Input: k.Input, Params: k.Params},
+	} {
+		if _, ok := s.Get(other); ok {
+			t.Errorf("Get found the entry for a different %s", name)
+		}
+	}
+
+	rebuilt := &Store{Dir: s.Dir, Build: "b"}
+	if _, ok := rebuilt.Get(k); ok {
+		t.Error("Get found an entry cached by a different build")
+	}
+}
+
+func TestNilStore(t *testing.T) {
+	var s *Store
+	k := Key{Year: 2022, Day: 1, Part: 1}
+	if err := s.Put(k, Entry{Answer: aoc.Int(1)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.Get(k); ok {
+		t.Error("a nil store had an entry")
+	}
+}
+
+func TestClear(t *testing.T) {
+	dir := t.TempDir()
+	a, b := &Store{Dir: dir, Build: "a"}, &Store{Dir: dir, Build: "b"}
+	for _, s := range []*Store{a, b} {
+		for _, k := range []Key{{Year: 2022, Day: 1, Part: 1}, {Year: 2022, Day: 1, Part: 2}, {Year: 2022, Day: 11, Part: 1}, {Year: 2021, Day: 1, Part: 1}} {
+			if err := s.Put(k, Entry{Answer: aoc.Int(k.Day)}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// day 1 mustn't take day 11 with it
+	if n, err := a.Clear(2022, 1); err != nil || n != 4 {
+		t.Fatalf("Clear(2022, 1) = %d, %v, want 4 removed from both builds", n, err)
+	}
+	if _, ok := b.Get(Key{Year: 2022, Day: 11, Part: 1}); !ok {
+		t.Error("Clear(2022, 1) removed day 11")
+	}
+	if n, err := a.Clear(2022, 0); err != nil || n != 2 {
+		t.Errorf("Clear(2022, 0) = %d, %v, want 2", n, err)
+	}
+	if n, err := a.Clear(0, 0); err != nil || n != 2 {
+		t.Errorf("Clear(0, 0) = %d, %v, want 2", n, err)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	dir := t.TempDir()
+	a, b := &Store{Dir: dir, Build: "a"}, &Store{Dir: dir, Build: "b"}
+	for i, s := range []*Store{a, b, b} {
+		if err := s.Put(Key{Year: 2022, Day: 1, Part: i}, Entry{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n, err := a.Prune(); err != nil || n != 2 {
+		t.Fatalf("Prune = %d, %v, want 2", n, err)
+	}
+	if current, other, err := a.Count(); err != nil || current != 1 || other != 0 {
+		t.Errorf("Count = %d, %d, %v, want 1, 0", current, other, err)
+	}
+}
+
+func TestBuildHash(t *testing.T) {
+	h, err := BuildHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := BuildHash(); h == "" || again != h {
+		t.Errorf("BuildHash = %q, then %q, want the same hash", h, again)
+	}
+}
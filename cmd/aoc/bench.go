@@ -0,0 +1,286 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// benchResult is the measurement of one part of one input.
+type benchResult struct {
+	Day         int    `json:"day"`
+	Part        int    `json:"part"`
+	Input       string `json:"input"` // "example" or "input"
+	N           int    `json:"n"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	BytesPerOp  int64  `json:"bytes_per_op"`
+	AllocsPerOp int64  `json:"allocs_per_op"`
+
+	baseline *benchResult // the same measurement in the baseline report, if any
+}
+
+// delta returns the change in time per op from the baseline, as a percentage.
+func (r benchResult) delta() float64 {
+	if r.baseline == nil || r.baseline.NsPerOp == 0 {
+		return 0
+	}
+
+	return float64(r.NsPerOp-r.baseline.NsPerOp) / float64(r.baseline.NsPerOp) * 100
+}
+
+func (r benchResult) status(threshold float64) string {
+	switch {
+	case r.baseline == nil:
+		return "new"
+	case r.delta() > threshold:
+		return "REGRESSED"
+	default:
+		return "ok"
+	}
+}
+
+// benchReport is the machine readable output of aoc bench, and the format of
+// the baseline it compares against.
+type benchReport struct {
+	GoVersion string        `json:"go_version"`
+	GOOS      string        `json:"goos"`
+	GOARCH    string        `json:"goarch"`
+	Results   []benchResult `json:"results"`
+}
+
+type benchKey struct {
+	day, part int
+	input     string
+}
+
+func benchCmd(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	year := fs.Int("year", aoc.DefaultYear, "year of the puzzles to benchmark")
+	day := fs.Int("day", 0, "only benchmark this day (default all)")
+	part := fs.Int("part", 0, "only benchmark this part (1 or 2), or 0 for both")
+	inputs := fs.String("inputs", "input", "which inputs to benchmark: example, input or all")
+	jsonPath := fs.String("json", "", "write the report as JSON to `path`")
+	mdPath := fs.String("markdown", "", "write the report as a Markdown table to `path`")
+	baselinePath := fs.String("baseline", "", "compare against the JSON report at `path`")
+	threshold := fs.Float64("threshold", 10, "`percent` slower than the baseline that counts as a regression")
+	benchTime := fs.Duration("benchtime", time.Second, "run each part for about `d`, or once if that takes longer")
+	fs.Parse(args)
+
+	var kinds []string
+	switch *inputs {
+	case "example", "input":
+		kinds = []string{*inputs}
+	case "all":
+		kinds = []string{"example", "input"}
+	default:
+		return fmt.Errorf("invalid -inputs %q", *inputs)
+	}
+
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+
+	baseline := map[benchKey]*benchResult{}
+	if *baselinePath != "" {
+		var err error
+		if baseline, err = readBaseline(*baselinePath); err != nil {
+			return err
+		}
+	}
+
+	report := benchReport{
+		GoVersion: runtime.Version(),
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+	}
+
+	for _, puzzle := range aoc.PuzzlesYear(*year) {
+		if *day != 0 && puzzle.Day != *day {
+			continue
+		}
+
+		for _, kind := range kinds {
+			in, params := puzzle.Input, puzzle.Params
+			if kind == "example" {
+				in, params = puzzle.Example, puzzle.ExampleParams
+			}
+
+			for n := 1; n <= 2; n++ {
+				if *part != 0 && *part != n {
+					continue
+				}
+
+				res, err := benchPart(puzzle, in, params, n, *benchTime)
+				if errors.Is(err, aoc.ErrNoPart) {
+					continue
+				} else if err != nil {
+					return fmt.Errorf("day %d part %d %s: %w", puzzle.Day, n, kind, err)
+				}
+
+				res.Input = kind
+				res.baseline = baseline[benchKey{res.Day, res.Part, res.Input}]
+				report.Results = append(report.Results, res)
+			}
+		}
+	}
+
+	printBench(os.Stdout, report, *baselinePath != "", *threshold)
+
+	if *jsonPath != "" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*jsonPath, append(data, '\n'), 0o644); err != nil {
+			return err
+		}
+	}
+
+	if *mdPath != "" {
+		f, err := os.Create(*mdPath)
+		if err != nil {
+			return err
+		}
+		writeBenchMarkdown(f, report, *baselinePath != "", *threshold)
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+
+	regressed := 0
+	for _, r := range report.Results {
+		if r.status(*threshold) == "REGRESSED" {
+			regressed++
+		}
+	}
+	if regressed > 0 {
+		return fmt.Errorf("%d of %d benchmarks regressed by more than %g%%", regressed, len(report.Results), *threshold)
+	}
+
+	return nil
+}
+
+// readBaseline reads the results of the JSON report at path.
+func readBaseline(path string) (map[benchKey]*benchResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var r benchReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("baseline %s: %w", path, err)
+	}
+
+	baseline := map[benchKey]*benchResult{}
+	for i, res := range r.Results {
+		baseline[benchKey{res.Day, res.Part, res.Input}] = &r.Results[i]
+	}
+	return baseline, nil
+}
+
+// benchPart measures one part of an input, parsed once, the way go test -bench
+// does: it runs the part as many times as fit in d, or once for the slow
+// days.
+func benchPart(puzzle aoc.Puzzle, in string, params aoc.Params, part int, d time.Duration) (benchResult, error) {
+	s, err := puzzle.Load(strings.NewReader(in), params)
+	if err != nil {
+		return benchResult{}, err
+	}
+
+	for n := 1; ; {
+		runtime.GC()
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		for i := 0; i < n; i++ {
+			if _, err := aoc.SolvePart(s, part); err != nil {
+				return benchResult{}, err
+			}
+		}
+		elapsed := time.Since(start)
+		runtime.ReadMemStats(&after)
+
+		if elapsed >= d {
+			return benchResult{
+				Day:         puzzle.Day,
+				Part:        part,
+				N:           n,
+				NsPerOp:     elapsed.Nanoseconds() / int64(n),
+				BytesPerOp:  int64(after.TotalAlloc-before.TotalAlloc) / int64(n),
+				AllocsPerOp: int64(after.Mallocs-before.Mallocs) / int64(n),
+			}, nil
+		}
+
+		// aim a fifth past d, going by this run, but grow by at most 100x
+		next := int(int64(d) * int64(n) / max(int64(elapsed), 1))
+		n = min(max(next+next/5, n+1), 100*n)
+	}
+}
+
+func formatDelta(r benchResult) string {
+	if r.baseline == nil {
+		return "-"
+	}
+
+	return fmt.Sprintf("%+.1f%%", r.delta())
+}
+
+// printBench writes the report as a table, with the baseline comparison if
+// there is one.
+func printBench(w io.Writer, report benchReport, compare bool, threshold float64) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if compare {
+		fmt.Fprintln(tw, "DAY\tPART\tINPUT\tTIME/OP\tB/OP\tALLOCS/OP\tBASELINE\tDELTA\tSTATUS")
+	} else {
+		fmt.Fprintln(tw, "DAY\tPART\tINPUT\tTIME/OP\tB/OP\tALLOCS/OP")
+	}
+
+	for _, r := range report.Results {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%d\t%d", r.Day, r.Part, r.Input, time.Duration(r.NsPerOp), r.BytesPerOp, r.AllocsPerOp)
+		if compare {
+			base := "-"
+			if r.baseline != nil {
+				base = time.Duration(r.baseline.NsPerOp).String()
+			}
+			fmt.Fprintf(tw, "\t%s\t%s\t%s", base, formatDelta(r), r.status(threshold))
+		}
+		fmt.Fprintln(tw)
+	}
+	tw.Flush()
+}
+
+// writeBenchMarkdown writes the report as a Markdown table, for pasting into
+// the README or a pull request.
+func writeBenchMarkdown(w io.Writer, report benchReport, compare bool, threshold float64) {
+	fmt.Fprintf(w, "%s %s/%s\n\n", report.GoVersion, report.GOOS, report.GOARCH)
+
+	if compare {
+		fmt.Fprintln(w, "| Day | Part | Input | Time/op | B/op | Allocs/op | Baseline | Delta | Status |")
+		fmt.Fprintln(w, "|----:|-----:|-------|--------:|-----:|----------:|---------:|------:|--------|")
+	} else {
+		fmt.Fprintln(w, "| Day | Part | Input | Time/op | B/op | Allocs/op |")
+		fmt.Fprintln(w, "|----:|-----:|-------|--------:|-----:|----------:|")
+	}
+
+	for _, r := range report.Results {
+		fmt.Fprintf(w, "| %d | %d | %s | %s | %d | %d |", r.Day, r.Part, r.Input, time.Duration(r.NsPerOp), r.BytesPerOp, r.AllocsPerOp)
+		if compare {
+			base := "-"
+			if r.baseline != nil {
+				base = time.Duration(r.baseline.NsPerOp).String()
+			}
+			fmt.Fprintf(w, " %s | %s | %s |", base, formatDelta(r), r.status(threshold))
+		}
+		fmt.Fprintln(w)
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// benchFixture is a report against testdata/baseline.json: day 1 part 1 got
+// faster, part 2 slower, and day 2 has no baseline.
+func benchFixture(t *testing.T) benchReport {
+	t.Helper()
+	baseline, err := readBaseline("testdata/baseline.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := benchReport{GoVersion: "go1.22.0", GOOS: "linux", GOARCH: "amd64", Results: []benchResult{
+		{Day: 1, Part: 1, Input: "input", NsPerOp: 800},
+		{Day: 1, Part: 2, Input: "input", NsPerOp: 1200},
+		{Day: 2, Part: 1, Input: "input", NsPerOp: 500},
+	}}
+	for i, r := range report.Results {
+		report.Results[i].baseline = baseline[benchKey{r.Day, r.Part, r.Input}]
+	}
+	return report
+}
+
+func TestBenchStatus(t *testing.T) {
+	report := benchFixture(t)
+	for i, want := range []struct {
+		delta  string
+		status string
+	}{
+		{"-20.0%", "ok"},
+		{"+20.0%", "REGRESSED"},
+		{"-", "new"},
+	} {
+		r := report.Results[i]
+		if got := formatDelta(r); got != want.delta {
+			t.Errorf("day %d part %d delta = %s, want %s", r.Day, r.Part, got, want.delta)
+		}
+		if got := r.status(10); got != want.status {
+			t.Errorf("day %d part %d status = %s, want %s", r.Day, r.Part, got, want.status)
+		}
+	}
+
+	if got := report.Results[1].status(25); got != "ok" {
+		t.Errorf("20%% slower with a 25%% threshold = %s, want ok", got)
+	}
+}
+
+func TestBenchMarkdown(t *testing.T) {
+	var b bytes.Buffer
+	writeBenchMarkdown(&b, benchFixture(t), true, 10)
+
+	want := `go1.22.0 linux/amd64
+
+| Day | Part | Input | Time/op | B/op | Allocs/op | Baseline | Delta | Status |
+|----:|-----:|-------|--------:|-----:|----------:|---------:|------:|--------|
+| 1 | 1 | input | 800ns | 0 | 0 | 1µs | -20.0% | ok |
+| 1 | 2 | input | 1.2µs | 0 | 0 | 1µs | +20.0% | REGRESSED |
+| 2 | 1 | input | 500ns | 0 | 0 | - | - | new |
+`
+	if b.String() != want {
+		t.Errorf("markdown =\n%s\nwant\n%s", &b, want)
+	}
+}
+
+func TestBenchText(t *testing.T) {
+	var b bytes.Buffer
+	printBench(&b, benchFixture(t), true, 10)
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 4 || !strings.HasSuffix(lines[2], "REGRESSED") || !strings.HasSuffix(lines[3], "new") {
+		t.Errorf("table =\n%s\nwant a header, then ok, REGRESSED and new rows", &b)
+	}
+}
+
+func TestBenchPart(t *testing.T) {
+	p, _ := aoc.Lookup(1)
+	r, err := benchPart(p, p.Example, p.ExampleParams, 1, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Day != 1 || r.Part != 1 || r.N < 1 || r.NsPerOp <= 0 {
+		t.Errorf("benchPart = %+v, want day 1 part 1 run at least once", r)
+	}
+
+	p, _ = aoc.Lookup(25)
+	if _, err := benchPart(p, p.Example, p.ExampleParams, 2, time.Millisecond); !errors.Is(err, aoc.ErrNoPart) {
+		t.Errorf("benchPart day 25 part 2 = %v, want ErrNoPart", err)
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/cache"
+)
+
+func cacheCmd(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: aoc cache status|clear|prune [flags]")
+	}
+
+	fs := flag.NewFlagSet("cache "+args[0], flag.ExitOnError)
+	year := fs.Int("year", aoc.DefaultYear, "with clear, the year to clear, or 0 for every year")
+	day := fs.Int("day", 0, "with clear, the day to clear, or 0 for every day")
+	fs.Parse(args[1:])
+
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return err
+	}
+	store, err := cache.Open(dir)
+	if err != nil {
+		return err
+	}
+
+	return cacheAction(os.Stdout, store, args[0], *year, *day)
+}
+
+// cacheAction reports on or removes answers in store.
+func cacheAction(w io.Writer, store *cache.Store, action string, year, day int) error {
+	switch action {
+	case "status":
+		current, other, err := store.Count()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\n%d answers for this build (%s), %d for other builds\n", store.Dir, current, store.Build, other)
+	case "clear":
+		n, err := store.Clear(year, day)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "removed %d answers\n", n)
+	case "prune":
+		n, err := store.Prune()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "removed %d answers of other builds\n", n)
+	default:
+		return fmt.Errorf("unknown cache command %q; want status, clear or prune", action)
+	}
+
+	return nil
+}
+
+// openCache returns the answer cache for run, or nil, having said why, if
+// there can't be one.
+func openCache() *cache.Store {
+	dir, err := cache.DefaultDir()
+	if err == nil {
+		var store *cache.Store
+		if store, err = cache.Open(dir); err == nil {
+			return store
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "not caching answers: %v\n", err)
+	return nil
+}
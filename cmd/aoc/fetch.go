@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/site"
+)
+
+func fetchCmd(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	year := fs.Int("year", aoc.DefaultYear, "year of the puzzle")
+	day := fs.Int("day", 0, "day of the puzzle (1-25)")
+	root := fs.String("root", ".", "the module's root `directory`")
+	force := fs.Bool("force", false, "overwrite input files that differ from the downloads")
+	fs.Parse(args)
+
+	session, err := site.Session()
+	if err != nil {
+		return err
+	}
+	c, err := site.New(session)
+	if err != nil {
+		return err
+	}
+
+	return fetch(context.Background(), os.Stdout, c, *root, *year, *day, *force)
+}
+
+// fetch downloads the input and example for day of year, or takes them from
+// c's cache, and writes them into the day's package under root, where they
+// are embedded. A file that's already there and differs is only overwritten
+// with force, since it may be someone else's input.
+func fetch(ctx context.Context, w io.Writer, c *site.Client, root string, year, day int, force bool) error {
+	dir := filepath.Join(root, filepath.FromSlash(dayPath(year, day)))
+	if _, err := os.Stat(dir); err != nil {
+		return fmt.Errorf("%s: %w; create it with aoc new", dir, err)
+	}
+
+	in, err := c.Input(ctx, year, day)
+	if err != nil {
+		return err
+	}
+	if err := save(w, filepath.Join(dir, "input.txt"), in, force); err != nil {
+		return err
+	}
+
+	ex, err := c.Example(ctx, year, day)
+	if err != nil {
+		// the input is what matters; an example can be pasted in by hand
+		fmt.Fprintf(w, "%s: %v\n", filepath.Join(dir, "example.txt"), err)
+		return nil
+	}
+
+	return save(w, filepath.Join(dir, "example.txt"), ex, force)
+}
+
+// save writes s to the file at path if it's missing or empty, or with force,
+// and says what it did.
+func save(w io.Writer, path, s string, force bool) error {
+	old, err := os.ReadFile(path)
+	switch {
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return err
+	case bytes.Equal(old, []byte(s)):
+		fmt.Fprintf(w, "%s: unchanged\n", path)
+		return nil
+	case len(old) > 0 && !force:
+		fmt.Fprintf(w, "%s: kept, as it differs from the download; -force overwrites it\n", path)
+		return nil
+	}
+
+	if err := os.WriteFile(path, []byte(s), 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "%s: written\n", path)
+	return nil
+}
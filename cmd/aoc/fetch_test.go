@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/site"
+)
+
+func TestFetch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2022/day/1/input", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("100\n200\n"))
+	})
+	mux.HandleFunc("GET /2022/day/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<p>For example:</p><pre><code>1\n2\n</code></pre>"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := &site.Client{BaseURL: srv.URL, Session: "secret", CacheDir: t.TempDir()}
+	root := t.TempDir()
+	dir := filepath.Join(root, "day1")
+	os.Mkdir(dir, 0o755)
+	os.WriteFile(filepath.Join(dir, "input.txt"), nil, 0o644)
+	os.WriteFile(filepath.Join(dir, "example.txt"), []byte("pasted\n"), 0o644)
+
+	tests := []struct {
+		force  bool
+		output string
+	}{
+		{false, "input.txt: written\nexample.txt: kept"},
+		{true, "input.txt: unchanged\nexample.txt: written"},
+	}
+	for _, tt := range tests {
+		var b bytes.Buffer
+		if err := fetch(context.Background(), &b, c, root, 2022, 1, tt.force); err != nil {
+			t.Fatal(err)
+		}
+
+		out := strings.ReplaceAll(b.String(), dir+string(filepath.Separator), "")
+		if !strings.HasPrefix(out, tt.output) {
+			t.Errorf("fetch with force %v wrote %q, want it to start %q", tt.force, out, tt.output)
+		}
+	}
+
+	if b, _ := os.ReadFile(filepath.Join(dir, "example.txt")); string(b) != "1\n2\n" {
+		t.Errorf("example.txt = %q, want the downloaded example", b)
+	}
+
+	if err := fetch(context.Background(), &bytes.Buffer{}, c, root, 2022, 2, false); err == nil {
+		t.Errorf("fetch for a day with no package succeeded")
+	}
+}
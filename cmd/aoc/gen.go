@@ -0,0 +1,44 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/nickshine/adventofcode2022/gen"
+)
+
+func genCmd(args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	day := fs.Int("day", 0, "day to generate an input for (1-25)")
+	seed := fs.Uint64("seed", 1, "random `seed`; the same seed always generates the same input")
+	size := fs.Int("size", 100, "roughly how many lines, items or cells to generate")
+	fs.Parse(args)
+
+	in, err := gen.Generate(*day, *seed, *size)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprint(os.Stdout, in.Text); err != nil {
+		return err
+	}
+
+	// the input only solves with its parameters, so say what they are
+	if len(in.Params) > 0 {
+		names := make([]string, 0, len(in.Params))
+		for name := range in.Params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fmt.Fprintf(os.Stderr, "solve with: aoc run -day %d -input path", *day)
+		for _, name := range names {
+			fmt.Fprintf(os.Stderr, " -p %s=%d", name, in.Params[name])
+		}
+		fmt.Fprintln(os.Stderr)
+	}
+
+	return nil
+}
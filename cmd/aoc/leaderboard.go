@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/leaderboard"
+	"github.com/nickshine/adventofcode2022/site"
+)
+
+type leaderboardOptions struct {
+	view   string // scores, timeline or deltas
+	member string // to show the timeline or deltas of, instead of everyone's
+	csv    bool
+	now    time.Time // to judge streaks at
+}
+
+func leaderboardCmd(args []string) error {
+	fs := flag.NewFlagSet("leaderboard", flag.ExitOnError)
+	file := fs.String("file", "", "read the leaderboard's JSON from `path` instead of downloading it")
+	id := fs.Int("id", 0, "the `ID` of the private leaderboard to download, as in its URL")
+	year := fs.Int("year", aoc.DefaultYear, "year of the leaderboard to download")
+	opts := leaderboardOptions{now: time.Now()}
+	fs.StringVar(&opts.view, "view", "scores", "what to show: scores, timeline or deltas")
+	fs.StringVar(&opts.member, "member", "", "show the timeline or deltas of the member with `name` or ID only")
+	fs.BoolVar(&opts.csv, "csv", false, "write CSV instead of a table")
+	fs.Parse(args)
+
+	var lb *leaderboard.Leaderboard
+	var err error
+	switch {
+	case *file != "":
+		lb, err = leaderboard.ReadFile(*file)
+	case *id != 0:
+		lb, err = downloadLeaderboard(*year, *id)
+	default:
+		return errors.New("give a leaderboard to read with -file or to download with -id")
+	}
+	if err != nil {
+		return err
+	}
+
+	return showLeaderboard(os.Stdout, lb, opts)
+}
+
+func downloadLeaderboard(year, id int) (*leaderboard.Leaderboard, error) {
+	session, err := site.Session()
+	if err != nil {
+		return nil, err
+	}
+	c, err := site.New(session)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := c.Leaderboard(context.Background(), year, id)
+	if err != nil {
+		return nil, err
+	}
+	return leaderboard.Parse(b)
+}
+
+// showLeaderboard writes the table of lb that opts asks for.
+func showLeaderboard(w io.Writer, lb *leaderboard.Leaderboard, opts leaderboardOptions) error {
+	members := lb.List()
+	if opts.member != "" {
+		if members = lb.Find(opts.member); len(members) == 0 {
+			return fmt.Errorf("no member %q on the leaderboard", opts.member)
+		}
+	}
+
+	var t leaderboard.Table
+	switch opts.view {
+	case "scores":
+		t = lb.ScoreTable(opts.now)
+	case "timeline":
+		t = lb.TimelineTable(members)
+	case "deltas":
+		t = lb.DeltaTable(members)
+	default:
+		return fmt.Errorf("unknown view %q; want scores, timeline or deltas", opts.view)
+	}
+
+	if opts.csv {
+		return t.WriteCSV(w)
+	}
+	return t.WriteText(w)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/leaderboard"
+)
+
+func TestShowLeaderboard(t *testing.T) {
+	lb, err := leaderboard.ReadFile("../../leaderboard/testdata/2022.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	opts := leaderboardOptions{view: "timeline", member: "bob", csv: true, now: time.Date(2022, 12, 4, 0, 0, 0, 0, time.UTC)}
+	if err := showLeaderboard(&b, lb, opts); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 6 || lines[1] != "Bob,1,1,2022-12-01T00:06:40-05:00,00:06:40" {
+		t.Errorf("Bob's timeline =\n%s", b.String())
+	}
+
+	opts.member = "nobody"
+	if err := showLeaderboard(&b, lb, opts); err == nil {
+		t.Error("showLeaderboard of a member who isn't there succeeded")
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// logFlag is the -log flag: a comma separated list of days to trace, or
+// all, each optionally with its own level as day=level.
+type logFlag map[int]string // level names by day, or 0 for every day
+
+func (t logFlag) String() string {
+	var days []string
+	for day, level := range t {
+		s := "all"
+		if day != 0 {
+			s = strconv.Itoa(day)
+		}
+		if level != "" {
+			s += "=" + level
+		}
+		days = append(days, s)
+	}
+	sort.Strings(days)
+
+	return strings.Join(days, ",")
+}
+
+func (t logFlag) Set(s string) error {
+	for _, item := range strings.Split(s, ",") {
+		day, level, _ := strings.Cut(item, "=")
+
+		n := 0
+		if day != "all" {
+			var err error
+			if n, err = strconv.Atoi(day); err != nil || n < 1 || n > 25 {
+				return fmt.Errorf("invalid day %q; want 1-25 or all", day)
+			}
+		}
+		if level != "" {
+			if _, err := aoc.ParseLevel(level); err != nil {
+				return err
+			}
+		}
+
+		t[n] = level
+	}
+
+	return nil
+}
+
+// startLogging sends the traces of the days in t to w as text, at their own
+// levels or else at level.
+func startLogging(w io.Writer, t logFlag, level string) error {
+	def, err := aoc.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	if len(t) == 0 {
+		return nil
+	}
+
+	levels := map[int]slog.Level{}
+	for day, name := range t {
+		levels[day] = def
+		if name != "" {
+			levels[day], _ = aoc.ParseLevel(name) // checked by Set
+		}
+	}
+
+	h := slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: aoc.LevelTrace, // the days' levels decide
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.LevelKey && len(groups) == 0 {
+				a.Value = slog.StringValue(aoc.LevelName(a.Value.Any().(slog.Level)))
+			}
+			return a
+		},
+	})
+	aoc.SetTracing(h, levels)
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestLogFlag(t *testing.T) {
+	f := logFlag{}
+	if err := f.Set("19=trace,21"); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Set("all=info"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.String(), "19=trace,21,all=info"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	for _, bad := range []string{"26", "x", "19=loud"} {
+		if err := f.Set(bad); err == nil {
+			t.Errorf("Set(%q) succeeded, want error", bad)
+		}
+	}
+}
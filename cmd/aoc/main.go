@@ -0,0 +1,72 @@
+// Command aoc runs the Advent of Code 2022 solutions.
+//
+// Usage:
+//
+//	aoc run [-year Y] -day 15 -part 2 [-input path|- | -example] [-p name=value ...] [-timeout d] [-log days] [-record path.gif|.svg|.cast]
+//	        [-timings] [-cpuprofile path] [-memprofile path] [-trace path] [-no-cache]
+//	aoc run -all [-year Y] [-part P] [-example] [-timeout d] [-workers n] [-json] [-no-cache]
+//	aoc verify [-year Y] [-day N] [-inputs example|input|all] [-manifest answers.json] [-record] [-timeout d]
+//	aoc bench [-year Y] [-day N] [-part P] [-benchtime d] [-json path] [-markdown path] [-baseline path]
+//	aoc serve [-addr host:port] [-year Y] [-playground dir]
+//	aoc gen -day N [-seed S] [-size N]
+//	aoc new -day N [-year Y] [-root dir]
+//	aoc fetch -day N [-year Y] [-root dir] [-force]
+//	aoc submit -day N -part P [-year Y] [-answer A | -input path] [-manifest path] [-n]
+//	aoc cache status|clear|prune [-year Y] [-day N]
+//	aoc leaderboard -file path | -id N [-year Y] [-view scores|timeline|deltas] [-member name] [-csv]
+package main
+
+import (
+	"fmt"
+	"os"
+
+	_ "github.com/nickshine/adventofcode2022/all"
+)
+
+const usage = `Usage: aoc <command> [flags]
+
+Commands:
+  run          solve a day's puzzle
+  verify       check every solver against the expected answers manifest
+  bench        benchmark every solver and compare against a baseline report
+  serve        run the dashboard for solving and watching puzzles in a browser
+  gen          generate a random puzzle input of any size
+  new          create the package for a new day from a template
+  fetch        download a day's input and example into its package
+  submit       submit a part's answer, unless it's known to be wrong
+  cache        show or remove the answers run has cached
+  leaderboard  analyze a private leaderboard
+
+Run "aoc <command> -h" for a command's flags.
+`
+
+var commands = map[string]func(args []string) error{
+	"run":         runCmd,
+	"verify":      verifyCmd,
+	"bench":       benchCmd,
+	"serve":       serveCmd,
+	"gen":         genCmd,
+	"new":         newCmd,
+	"fetch":       fetchCmd,
+	"submit":      submitCmd,
+	"cache":       cacheCmd,
+	"leaderboard": leaderboardCmd,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	if err := cmd(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "aoc %s: %s\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed templates
+var templates embed.FS
+
+var dayTemplates = template.Must(template.ParseFS(templates, "templates/*.tmpl"))
+
+func newCmd(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	year := fs.Int("year", aoc.DefaultYear, "year of the puzzle")
+	day := fs.Int("day", 0, "day of the puzzle (1-25)")
+	root := fs.String("root", ".", "the module's root `directory`")
+	fs.Parse(args)
+
+	files, err := scaffold(*root, *year, *day)
+	for _, f := range files {
+		fmt.Println(f)
+	}
+
+	return err
+}
+
+// dayPackage is what the templates know about the day they're creating.
+type dayPackage struct {
+	Module  string // the module's path
+	Year    int    // zero for aoc.DefaultYear
+	Day     int
+	Package string
+}
+
+// Helper returns the name of the aoctest function fn for the day's year.
+func (d dayPackage) Helper(fn string) string {
+	if d.Year != 0 {
+		return fn + "Year"
+	}
+	return fn
+}
+
+// Args returns the arguments identifying the day to aoctest's functions.
+func (d dayPackage) Args() string {
+	if d.Year != 0 {
+		return fmt.Sprintf("%d, %d", d.Year, d.Day)
+	}
+	return strconv.Itoa(d.Day)
+}
+
+// scaffold creates the package for a day under the module at root: dayN for
+// aoc.DefaultYear or YEAR/dayN for another year, with a solver to fill in, its
+// tests and empty input files, and registers it in the all package. It
+// returns the files it created or changed.
+func scaffold(root string, year, day int) ([]string, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("invalid day %d", day)
+	}
+	if year < 2015 {
+		return nil, fmt.Errorf("invalid year %d; Advent of Code started in 2015", year)
+	}
+
+	module, err := modulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+
+	d := dayPackage{Module: module, Day: day, Package: fmt.Sprintf("day%d", day)}
+	if year != aoc.DefaultYear {
+		d.Year = year
+	}
+	rel := dayPath(year, day)
+
+	dir := filepath.Join(root, filepath.FromSlash(rel))
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("%s already exists", dir)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	files := map[string][]byte{"example.txt": nil, "input.txt": nil}
+	for name, tmpl := range map[string]string{
+		d.Package + ".go":      "day.go.tmpl",
+		d.Package + "_test.go": "day_test.go.tmpl",
+	} {
+		var b bytes.Buffer
+		if err := dayTemplates.ExecuteTemplate(&b, tmpl, d); err != nil {
+			return nil, err
+		}
+		src, err := format.Source(b.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", tmpl, err)
+		}
+		files[name] = src
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	var created []string
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, files[name], 0o644); err != nil {
+			return created, err
+		}
+		created = append(created, p)
+	}
+
+	all := filepath.Join(root, "all", "all.go")
+	if err := addImport(all, module+"/"+rel); err != nil {
+		return created, err
+	}
+
+	return append(created, all), nil
+}
+
+// dayPath returns the slash-separated path of the package for day of year,
+// relative to the module's root.
+func dayPath(year, day int) string {
+	if year == aoc.DefaultYear {
+		return fmt.Sprintf("day%d", day)
+	}
+	return fmt.Sprintf("%d/day%d", year, day)
+}
+
+// modulePath returns the module path declared in the go.mod file at path.
+func modulePath(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("not the module's root: %w", err)
+	}
+
+	for _, line := range strings.Split(string(b), "\n") {
+		if m, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.Trim(strings.TrimSpace(m), `"`), nil
+		}
+	}
+
+	return "", fmt.Errorf("%s declares no module", path)
+}
+
+// addImport adds a blank import of pkg to the import block of the Go file at
+// path, keeping the imports sorted.
+func addImport(path, pkg string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	src := string(b)
+	start := strings.Index(src, "import (\n")
+	if start < 0 {
+		return fmt.Errorf("%s has no import block", path)
+	}
+	start += len("import (\n")
+	end := strings.Index(src[start:], ")")
+	if end < 0 {
+		return fmt.Errorf("%s has no import block", path)
+	}
+	end += start
+
+	imports := strings.Split(strings.TrimRight(src[start:end], "\n"), "\n")
+	imports = append(imports, fmt.Sprintf("\t_ %q", pkg))
+	sort.Strings(imports)
+
+	out, err := format.Source([]byte(src[:start] + strings.Join(imports, "\n") + "\n" + src[end:]))
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, out, 0o644)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestScaffold(t *testing.T) {
+	root := t.TempDir()
+	os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/aoc\n\ngo 1.22\n"), 0o644)
+	os.Mkdir(filepath.Join(root, "all"), 0o755)
+	os.WriteFile(filepath.Join(root, "all", "all.go"), []byte("package all\n\nimport (\n\t_ \"example.com/aoc/day1\"\n\t_ \"example.com/aoc/day3\"\n)\n"), 0o644)
+
+	if _, err := scaffold(root, 2022, 2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := scaffold(root, 2023, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	for path, want := range map[string]string{
+		"day2/day2.go":           "Day:     2,",
+		"day2/day2_test.go":      "aoctest.Run(t, 2,",
+		"2023/day1/day1.go":      "Year:    2023,",
+		"2023/day1/day1_test.go": "aoctest.RunYear(t, 2023, 1,",
+		"2023/day1/example.txt":  "",
+		"all/all.go":             "\t_ \"example.com/aoc/2023/day1\"\n\t_ \"example.com/aoc/day1\"\n\t_ \"example.com/aoc/day2\"\n\t_ \"example.com/aoc/day3\"\n",
+	} {
+		b, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(path)))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if !strings.Contains(string(b), want) {
+			t.Errorf("%s = %s, want it to contain %q", path, b, want)
+		}
+	}
+
+	if _, err := scaffold(root, 2022, 2); err == nil {
+		t.Errorf("scaffolding day 2 again succeeded")
+	}
+	if _, err := scaffold(root, 2022, 26); err == nil {
+		t.Errorf("scaffolding day 26 succeeded")
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"time"
+)
+
+// profiles are the profiles of a run asked for with -cpuprofile, -memprofile
+// and -trace, each written to its path if it's set.
+type profiles struct {
+	cpu, mem, trace string
+
+	cpuFile, traceFile *os.File
+}
+
+// start starts the CPU profile and the execution trace.
+func (p *profiles) start() error {
+	if p.cpu != "" {
+		f, err := os.Create(p.cpu)
+		if err != nil {
+			return err
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return err
+		}
+		p.cpuFile = f
+	}
+
+	if p.trace != "" {
+		f, err := os.Create(p.trace)
+		if err != nil {
+			p.stop()
+			return err
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			p.stop()
+			return err
+		}
+		p.traceFile = f
+	}
+
+	return nil
+}
+
+// stop stops the CPU profile and the execution trace, and writes the memory
+// profile of every allocation made since the program started.
+func (p *profiles) stop() error {
+	var errs []error
+	if p.cpuFile != nil {
+		pprof.StopCPUProfile()
+		errs = append(errs, p.cpuFile.Close())
+		p.cpuFile = nil
+	}
+	if p.traceFile != nil {
+		trace.Stop()
+		errs = append(errs, p.traceFile.Close())
+		p.traceFile = nil
+	}
+
+	if p.mem != "" {
+		errs = append(errs, writeProfile(p.mem, "allocs"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func writeProfile(path, name string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	runtime.GC() // bring the heap statistics up to date
+	if err := pprof.Lookup(name).WriteTo(f, 0); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// phases times each phase of a run, parsing and each part, marking them as
+// regions in the execution trace too.
+type phases struct {
+	names []string
+	times []time.Duration
+}
+
+// time runs fn as the named phase.
+func (p *phases) time(name string, fn func()) {
+	start := time.Now()
+	trace.WithRegion(context.Background(), name, fn)
+	p.names = append(p.names, name)
+	p.times = append(p.times, time.Since(start))
+}
+
+// print writes each phase's time and the total on one line, such as
+// "parse 1.2ms, part 1 3.4ms, part 2 5.6ms, total 10.2ms".
+func (p *phases) print(w io.Writer) {
+	var total time.Duration
+	for i, name := range p.names {
+		fmt.Fprintf(w, "%s %s, ", name, p.times[i].Round(time.Microsecond))
+		total += p.times[i]
+	}
+	fmt.Fprintf(w, "total %s\n", total.Round(time.Microsecond))
+}
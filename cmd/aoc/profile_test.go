@@ -0,0 +1,40 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProfiles(t *testing.T) {
+	dir := t.TempDir()
+	p := &profiles{
+		cpu:   filepath.Join(dir, "cpu.out"),
+		mem:   filepath.Join(dir, "mem.out"),
+		trace: filepath.Join(dir, "trace.out"),
+	}
+	if err := p.start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{p.cpu, p.mem, p.trace} {
+		if fi, err := os.Stat(path); err != nil || fi.Size() == 0 {
+			t.Errorf("%s wasn't written: %v", filepath.Base(path), err)
+		}
+	}
+}
+
+func TestPhases(t *testing.T) {
+	p := phases{names: []string{"parse", "part 1"}, times: []time.Duration{time.Millisecond, 2 * time.Millisecond}}
+
+	var b strings.Builder
+	p.print(&b)
+	if got, want := b.String(), "parse 1ms, part 1 2ms, total 3ms\n"; got != want {
+		t.Errorf("print = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/cache"
+)
+
+func runCmd(args []string) (err error) {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	year := fs.Int("year", aoc.DefaultYear, "year of the puzzle to solve")
+	day := fs.Int("day", 0, "day to solve (1-25)")
+	part := fs.Int("part", 0, "part to solve (1 or 2), or 0 for both")
+	input := fs.String("input", "", "read the puzzle input from `path` instead of the embedded input (- for stdin)")
+	example := fs.Bool("example", false, "use the embedded example input and its parameters")
+	overrides := aoc.Params{}
+	fs.Var(overrides, "p", "set a solution parameter as `name=value` (repeatable)")
+	record := fs.String("record", "", "record the simulation to `path`, a .gif, .svg or .cast file")
+	every := fs.Int("every", 1, "with -record, keep only every `n`th frame")
+	delay := fs.Duration("delay", 100*time.Millisecond, "with -record, how long to show each frame")
+	timeout := fs.Duration("timeout", 0, "give up on a part after `duration`, printing the best answer found so far (0 for no limit)")
+	all := fs.Bool("all", false, "solve every day, -workers at a time, and summarize the results")
+	workers := fs.Int("workers", runtime.NumCPU(), "with -all, how many parts to solve at once")
+	jsonOut := fs.Bool("json", false, "with -all, write the results as JSON instead of a table")
+	logged := logFlag{}
+	fs.Var(logged, "log", "log debug traces of `days` to stderr, as a comma separated list of days or all, each optionally day=level (repeatable)")
+	logLevel := fs.String("log-level", "debug", "the `level` to log days at: trace, debug, info, warn or error")
+	prof := &profiles{}
+	fs.StringVar(&prof.cpu, "cpuprofile", "", "write a CPU profile of the run to `path`")
+	fs.StringVar(&prof.mem, "memprofile", "", "write a memory profile of the run's allocations to `path`")
+	fs.StringVar(&prof.trace, "trace", "", "write an execution trace of the run to `path`")
+	timings := fs.Bool("timings", false, "report how long parsing and each part took, on stderr")
+	noCache := fs.Bool("no-cache", false, "solve every part, neither using nor saving cached answers")
+	fs.Parse(args)
+
+	if err := startLogging(os.Stderr, logged, *logLevel); err != nil {
+		return err
+	}
+
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+
+	if *all {
+		if *day != 0 || *input != "" || len(overrides) > 0 || *record != "" {
+			return errors.New("-all can't be combined with -day, -input, -p or -record")
+		}
+		if prof.cpu != "" || prof.mem != "" || prof.trace != "" || *timings {
+			return errors.New("-all can't be profiled or timed; it reports each part's time already")
+		}
+		if *workers < 1 {
+			return fmt.Errorf("invalid -workers %d", *workers)
+		}
+		opts := runAllOptions{year: *year, part: *part, example: *example, timeout: *timeout, workers: *workers, json: *jsonOut}
+		if !*noCache && len(logged) == 0 {
+			opts.cache = openCache()
+		}
+		return runAll(os.Stdout, opts)
+	}
+
+	puzzle, ok := aoc.LookupYear(*year, *day)
+	if !ok {
+		return fmt.Errorf("no solution for day %d of %d", *day, *year)
+	}
+
+	in, params := puzzle.Input, puzzle.Params
+	if *example {
+		in, params = puzzle.Example, puzzle.ExampleParams
+	}
+	params = params.Merge(overrides)
+	if *input != "" {
+		if in, err = aoc.ReadInputFile(*input); err != nil {
+			return err
+		}
+	}
+
+	// a cached answer would skip whatever is asked to be recorded, traced,
+	// profiled or timed
+	var store *cache.Store
+	if !*noCache && *record == "" && len(logged) == 0 && prof.cpu == "" && prof.mem == "" && prof.trace == "" && !*timings {
+		store = openCache()
+	}
+	key := func(n int) cache.Key {
+		return cache.Key{Year: *year, Day: *day, Part: n, Input: in, Params: params}
+	}
+	cached := map[int]cache.Entry{}
+	unsolved := 0
+	for n := 1; n <= 2; n++ {
+		if *part != 0 && *part != n {
+			continue
+		}
+		if e, ok := store.Get(key(n)); ok {
+			cached[n] = e
+		} else {
+			unsolved++
+		}
+	}
+
+	if err := prof.start(); err != nil {
+		return err
+	}
+	defer func() {
+		if perr := prof.stop(); err == nil {
+			err = perr
+		}
+	}()
+
+	// deferred, so that a part that fails or times out still reports how
+	// long it and those before it took
+	var ph phases
+	if *timings {
+		defer ph.print(os.Stderr)
+	}
+	var s aoc.Solver
+	if unsolved > 0 {
+		ph.time("parse", func() {
+			s, err = puzzle.Load(strings.NewReader(in), params)
+		})
+		if err != nil {
+			return fmt.Errorf("day %d: %w", *day, err)
+		}
+	}
+
+	var rec *anim.Recording
+	if *record != "" {
+		r, ok := s.(anim.Recordable)
+		if !ok {
+			return fmt.Errorf("day %d has no simulation to record", *day)
+		}
+		if err := anim.CheckPath(*record); err != nil {
+			return err
+		}
+		rec = &anim.Recording{Every: *every, Delay: *delay}
+		r.Record(rec)
+	}
+
+	for n := 1; n <= 2; n++ {
+		if *part != 0 && *part != n {
+			continue
+		}
+
+		if e, ok := cached[n]; ok {
+			if !e.NoPart {
+				printAnswer(os.Stdout, *day, n, e.Answer)
+			} else if *part != 0 {
+				return fmt.Errorf("day %d part %d: %w", *day, n, aoc.ErrNoPart)
+			}
+			continue
+		}
+
+		var answer aoc.Answer
+		ph.time(fmt.Sprintf("part %d", n), func() {
+			answer, err = solve(s, n, *timeout)
+		})
+		if err == nil || errors.Is(err, aoc.ErrNoPart) {
+			e := cache.Entry{Answer: answer, NoPart: err != nil, Elapsed: ph.times[len(ph.times)-1], Solved: time.Now()}
+			if err := store.Put(key(n), e); err != nil {
+				fmt.Fprintf(os.Stderr, "caching the answer: %v\n", err)
+			}
+		}
+		if errors.Is(err, aoc.ErrNoPart) && *part == 0 {
+			continue
+		} else if errors.Is(err, context.DeadlineExceeded) {
+			if aoc.IsPartial(err) {
+				printAnswer(os.Stdout, *day, n, answer)
+			}
+			return fmt.Errorf("day %d part %d: gave up after %s: %w", *day, n, *timeout, err)
+		} else if err != nil {
+			return fmt.Errorf("day %d part %d: %w", *day, n, err)
+		}
+
+		printAnswer(os.Stdout, *day, n, answer)
+	}
+
+	if rec != nil {
+		if err := rec.WriteFile(*record); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "recorded %d frames to %s\n", len(rec.Frames), *record)
+	}
+
+	return nil
+}
+
+// solve solves a part, giving up after timeout if it's positive.
+func solve(s aoc.Solver, part int, timeout time.Duration) (aoc.Answer, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return aoc.SolvePartContext(ctx, s, part)
+}
+
+// printAnswer writes an answer as "Day N Part P: answer". Multi-line answers,
+// such as the day 10 CRT image, start on the line below.
+func printAnswer(w io.Writer, day, part int, answer aoc.Answer) {
+	s := answer.String()
+	if strings.Contains(s, "\n") {
+		fmt.Fprintf(w, "Day %d Part %d:\n%s\n", day, part, strings.TrimRight(s, "\n"))
+		return
+	}
+
+	fmt.Fprintf(w, "Day %d Part %d: %s\n", day, part, s)
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/cache"
+)
+
+type runAllOptions struct {
+	year    int
+	part    int  // 1 or 2, or 0 for both
+	example bool // solve the examples instead of the puzzle inputs
+	timeout time.Duration
+	workers int
+	json    bool
+	cache   *cache.Store // answers to skip solving, and to save new ones in
+}
+
+// partResult is the outcome of solving one part for run -all.
+type partResult struct {
+	Day       int         `json:"day"`
+	Part      int         `json:"part"`
+	Answer    *aoc.Answer `json:"answer,omitempty"`
+	Error     string      `json:"error,omitempty"`
+	ElapsedNs int64       `json:"elapsed_ns"`
+
+	// AllocBytes is how much the heap grew by while solving. It includes
+	// whatever the other workers allocated at the same time, so it is only
+	// exact with a single worker.
+	AllocBytes uint64 `json:"alloc_bytes"`
+
+	// Cached is whether the answer came from the cache. ElapsedNs is then
+	// how long it took to solve when it was cached.
+	Cached bool `json:"cached,omitempty"`
+
+	noPart bool // the puzzle has no such part
+}
+
+// runAll solves every part of every registered day of a year in a pool of workers and
+// writes a summary of the results, in day order, to w.
+func runAll(w io.Writer, opts runAllOptions) error {
+	var jobs []partResult
+	for _, p := range aoc.PuzzlesYear(opts.year) {
+		for n := 1; n <= 2; n++ {
+			if opts.part == 0 || opts.part == n {
+				jobs = append(jobs, partResult{Day: p.Day, Part: n})
+			}
+		}
+	}
+
+	start := time.Now()
+	queue := make(chan *partResult)
+	var wg sync.WaitGroup
+	for i := 0; i < opts.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range queue {
+				solveAll(r, opts)
+			}
+		}()
+	}
+	for i := range jobs {
+		queue <- &jobs[i]
+	}
+	close(queue)
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	results := jobs[:0]
+	failed := 0
+	for _, r := range jobs {
+		if r.noPart {
+			continue
+		}
+		if r.Error != "" {
+			failed++
+		}
+		results = append(results, r)
+	}
+
+	if opts.json {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+	} else {
+		printResults(w, results)
+		fmt.Fprintf(w, "\n%d parts in %s, %d at a time\n", len(results), elapsed.Round(time.Millisecond), opts.workers)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d parts failed", failed, len(results))
+	}
+
+	return nil
+}
+
+// solveAll loads and solves the part r describes, filling in its outcome.
+func solveAll(r *partResult, opts runAllOptions) {
+	puzzle, _ := aoc.LookupYear(opts.year, r.Day)
+	in, params := puzzle.Input, puzzle.Params
+	if opts.example {
+		in, params = puzzle.Example, puzzle.ExampleParams
+	}
+
+	key := cache.Key{Year: opts.year, Day: r.Day, Part: r.Part, Input: in, Params: params}
+	if e, ok := opts.cache.Get(key); ok {
+		r.noPart, r.Cached, r.ElapsedNs = e.NoPart, true, e.Elapsed.Nanoseconds()
+		if !e.NoPart {
+			r.Answer = &e.Answer
+		}
+		return
+	}
+
+	s, err := puzzle.Load(strings.NewReader(in), params)
+	if err != nil {
+		r.Error = err.Error()
+		return
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	answer, err := solve(s, r.Part, opts.timeout)
+	r.ElapsedNs = time.Since(start).Nanoseconds()
+	runtime.ReadMemStats(&after)
+	r.AllocBytes = after.TotalAlloc - before.TotalAlloc
+
+	switch {
+	case errors.Is(err, aoc.ErrNoPart):
+		r.noPart = true
+	case err != nil:
+		r.Error = err.Error()
+		if aoc.IsPartial(err) { // the best found before the timeout
+			r.Answer = &answer
+		}
+		return
+	default:
+		r.Answer = &answer
+	}
+
+	e := cache.Entry{Answer: answer, NoPart: r.noPart, Elapsed: time.Duration(r.ElapsedNs), Solved: time.Now()}
+	if err := opts.cache.Put(key, e); err != nil {
+		// the answer stands; it's only solved again next time
+		fmt.Fprintf(os.Stderr, "day %d part %d: caching the answer: %v\n", r.Day, r.Part, err)
+	}
+}
+
+// printResults writes results as a table. Multi-line answers, such as the day
+// 10 CRT image, don't fit in a table and follow it.
+func printResults(w io.Writer, results []partResult) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DAY\tPART\tANSWER\tTIME\tALLOC\tERROR")
+
+	var long []partResult
+	for _, r := range results {
+		answer := "-"
+		if r.Answer != nil {
+			answer = r.Answer.String()
+			if strings.Contains(answer, "\n") {
+				answer = "(below)"
+				long = append(long, r)
+			}
+		}
+		elapsed, alloc := time.Duration(r.ElapsedNs).Round(time.Microsecond).String(), formatBytes(r.AllocBytes)
+		if r.Cached {
+			elapsed, alloc = "cached", "-"
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%s\n", r.Day, r.Part, answer, elapsed, alloc, r.Error)
+	}
+	tw.Flush()
+
+	for _, r := range long {
+		fmt.Fprintln(w)
+		printAnswer(w, r.Day, r.Part, *r.Answer)
+	}
+}
+
+// formatBytes returns n in the largest unit that keeps it at least one.
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/cache"
+)
+
+func TestRunAll(t *testing.T) {
+	var b bytes.Buffer
+	if err := runAll(&b, runAllOptions{year: aoc.DefaultYear, part: 1, example: true, workers: 4, json: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	var results []partResult
+	if err := json.Unmarshal(b.Bytes(), &results); err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 25 {
+		t.Fatalf("got %d results, want one for part 1 of each of 25 days", len(results))
+	}
+	for i, r := range results {
+		if r.Day != i+1 || r.Part != 1 || r.Answer == nil {
+			t.Errorf("result %d = %+v, want an answer to day %d part 1", i, r, i+1)
+		}
+	}
+	if got := results[0].Answer.String(); got != "24000" {
+		t.Errorf("day 1 part 1 = %s, want 24000", got)
+	}
+}
+
+func TestRunAllCached(t *testing.T) {
+	opts := runAllOptions{year: aoc.DefaultYear, part: 1, example: true, workers: 4, json: true, cache: &cache.Store{Dir: t.TempDir(), Build: "test"}}
+
+	var results [2][]partResult
+	for i := range results {
+		var b bytes.Buffer
+		if err := runAll(&b, opts); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(b.Bytes(), &results[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i, r := range results[1] {
+		first := results[0][i]
+		if first.Cached || !r.Cached {
+			t.Errorf("day %d: cached %t, then %t, want solved, then cached", r.Day, first.Cached, r.Cached)
+		}
+		if r.Answer == nil || *r.Answer != *first.Answer {
+			t.Errorf("day %d: cached answer %v, want %v", r.Day, r.Answer, first.Answer)
+		}
+	}
+}
+
+func TestRunAllCacheFails(t *testing.T) {
+	// a file where the cache's directory should be, so every Put fails
+	dir := filepath.Join(t.TempDir(), "answers")
+	if err := os.WriteFile(dir, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	opts := runAllOptions{year: aoc.DefaultYear, part: 1, example: true, workers: 4, json: true, cache: &cache.Store{Dir: dir, Build: "test"}}
+
+	var b bytes.Buffer
+	if err := runAll(&b, opts); err != nil {
+		t.Fatal(err)
+	}
+	var results []partResult
+	if err := json.Unmarshal(b.Bytes(), &results); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if r.Error != "" || r.Answer == nil {
+			t.Errorf("day %d: answer %v, error %q, want the answer despite the cache", r.Day, r.Answer, r.Error)
+		}
+	}
+}
+
+func TestRunAllPartial(t *testing.T) {
+	// day 19's robot factories take far longer than a millisecond
+	r := partResult{Day: 19, Part: 1}
+	solveAll(&r, runAllOptions{year: aoc.DefaultYear, example: true, timeout: time.Millisecond})
+	if r.Answer == nil || !strings.Contains(r.Error, "best found so far") {
+		t.Errorf("answer %v, error %q, want the best answer found before the timeout", r.Answer, r.Error)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	for n, want := range map[uint64]string{
+		0:       "0 B",
+		1023:    "1023 B",
+		1536:    "1.5 KiB",
+		3 << 30: "3.0 GiB",
+	} {
+		if got := formatBytes(n); got != want {
+			t.Errorf("formatBytes(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
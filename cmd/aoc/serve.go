@@ -0,0 +1,283 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed dashboard.html
+var dashboardHTML []byte
+
+// maxUpload is the largest puzzle input the dashboard accepts.
+const maxUpload = 10 << 20
+
+func serveCmd(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", "localhost:8080", "listen on `address`")
+	year := fs.Int("year", aoc.DefaultYear, "year of the puzzles to serve")
+	playground := fs.String("playground", "", "also serve the WebAssembly playground built in `directory`, such as cmd/playground")
+	fs.Parse(args)
+
+	fmt.Fprintf(os.Stderr, "dashboard on http://%s\n", *addr)
+	h := newDashboard(*year)
+	if *playground != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/", h)
+		mux.Handle("GET /playground/", http.StripPrefix("/playground/", http.FileServer(http.Dir(*playground))))
+		h = mux
+		fmt.Fprintf(os.Stderr, "playground on http://%s/playground/\n", *addr)
+	}
+	return http.ListenAndServe(*addr, h)
+}
+
+// newDashboard returns the handler for the dashboard page and the JSON API
+// behind it, for the puzzles of year:
+//
+//	GET  /api/days                                  every day and its parameters
+//	POST /api/days/{day}/parts/{part}               solve a part
+//	POST /api/days/{day}/parts/{part}/animation.svg record a part's simulation
+//
+// A part is solved against the embedded input, or with ?input=example the
+// embedded example, or the request body if there is one. Parameters are
+// overridden with repeated ?p=name=value. ?visual=1 has a solve respond with
+// the last frame of the part's simulation, at the cost of drawing every frame.
+func newDashboard(year int) http.Handler {
+	d := dashboard{year}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(dashboardHTML)
+	})
+	mux.HandleFunc("GET /api/days", d.listDays)
+	mux.HandleFunc("POST /api/days/{day}/parts/{part}", d.solveHandler)
+	mux.HandleFunc("POST /api/days/{day}/parts/{part}/animation.svg", d.animationHandler)
+
+	return mux
+}
+
+// dashboard serves the API for the puzzles of a year.
+type dashboard struct {
+	year int
+}
+
+// dayInfo describes a day for the dashboard.
+type dayInfo struct {
+	Day           int        `json:"day"`
+	Params        aoc.Params `json:"params"`
+	ExampleParams aoc.Params `json:"example_params"`
+	Recordable    bool       `json:"recordable"` // whether it has a simulation to animate
+}
+
+func (d dashboard) listDays(w http.ResponseWriter, r *http.Request) {
+	var days []dayInfo
+	for _, p := range aoc.PuzzlesYear(d.year) {
+		_, recordable := p.New(p.Params).(anim.Recordable)
+		days = append(days, dayInfo{
+			Day:           p.Day,
+			Params:        p.Params,
+			ExampleParams: p.ExampleParams,
+			Recordable:    recordable,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, days)
+}
+
+// solveResult is the outcome of solving a part through the API.
+type solveResult struct {
+	Day       int         `json:"day"`
+	Part      int         `json:"part"`
+	Input     string      `json:"input"` // "input", "example" or "upload"
+	Answer    *aoc.Answer `json:"answer,omitempty"`
+	Error     string      `json:"error,omitempty"`
+	ElapsedNs int64       `json:"elapsed_ns"`
+	Elapsed   string      `json:"elapsed"`
+
+	// Frames is how many frames the part's simulation drew, and Visual the
+	// last of them, if it has one.
+	Frames int        `json:"frames,omitempty"`
+	Visual anim.Frame `json:"visual,omitempty"`
+}
+
+// lastFrame is a Recorder that keeps only the latest frame.
+type lastFrame struct {
+	frame anim.Frame
+	n     int
+}
+
+func (l *lastFrame) Frame(f anim.Frame) {
+	l.frame = f
+	l.n++
+}
+
+// solveRequest is a part to solve, read from an API request.
+type solveRequest struct {
+	puzzle aoc.Puzzle
+	part   int
+	kind   string
+	in     string
+	params aoc.Params
+	visual bool // whether to record the part's simulation for its last frame
+}
+
+// readSolveRequest reads the day, part, input and parameters of r. Its errors
+// are the client's fault.
+func (d dashboard) readSolveRequest(w http.ResponseWriter, r *http.Request) (solveRequest, error) {
+	var req solveRequest
+
+	day, err := strconv.Atoi(r.PathValue("day"))
+	if err != nil {
+		return req, fmt.Errorf("invalid day %q", r.PathValue("day"))
+	}
+	var ok bool
+	if req.puzzle, ok = aoc.LookupYear(d.year, day); !ok {
+		return req, fmt.Errorf("no solution for day %d of %d", day, d.year)
+	}
+
+	req.part, err = strconv.Atoi(r.PathValue("part"))
+	if err != nil || req.part < 1 || req.part > 2 {
+		return req, fmt.Errorf("invalid part %q", r.PathValue("part"))
+	}
+
+	q := r.URL.Query()
+	req.kind = q.Get("input")
+	switch req.kind {
+	case "", "input":
+		req.kind, req.in, req.params = "input", req.puzzle.Input, req.puzzle.Params
+	case "example":
+		req.in, req.params = req.puzzle.Example, req.puzzle.ExampleParams
+	default:
+		return req, fmt.Errorf("invalid input %q; want input or example", req.kind)
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUpload))
+	if err != nil {
+		return req, fmt.Errorf("reading input: %w", err)
+	}
+	if strings.TrimSpace(string(body)) != "" {
+		req.kind, req.in = "upload", string(body)
+	}
+
+	if v := q.Get("visual"); v != "" {
+		if req.visual, err = strconv.ParseBool(v); err != nil {
+			return req, fmt.Errorf("invalid visual %q", v)
+		}
+	}
+
+	overrides := aoc.Params{}
+	for _, p := range q["p"] {
+		if err := overrides.Set(p); err != nil {
+			return req, err
+		}
+	}
+	req.params = req.params.Merge(overrides)
+
+	return req, nil
+}
+
+func (d dashboard) solveHandler(w http.ResponseWriter, r *http.Request) {
+	req, err := d.readSolveRequest(w, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res := solveResult{Day: req.puzzle.Day, Part: req.part, Input: req.kind}
+	s, err := req.puzzle.Load(strings.NewReader(req.in), req.params)
+	if err != nil {
+		res.Error = err.Error()
+		writeJSON(w, http.StatusUnprocessableEntity, res)
+		return
+	}
+
+	// drawing every frame slows some simulations down by orders of
+	// magnitude, so it's only done when asked for
+	var last lastFrame
+	if rec, ok := s.(anim.Recordable); ok && req.visual {
+		rec.Record(&last)
+	}
+
+	start := time.Now()
+	answer, err := aoc.SolvePartContext(r.Context(), s, req.part)
+	elapsed := time.Since(start)
+
+	res.ElapsedNs, res.Elapsed = elapsed.Nanoseconds(), elapsed.Round(time.Microsecond).String()
+	res.Frames, res.Visual = last.n, last.frame
+
+	status := http.StatusOK
+	switch {
+	case errors.Is(err, aoc.ErrNoPart):
+		res.Error, status = err.Error(), http.StatusNotFound
+	case err != nil:
+		res.Error, status = err.Error(), http.StatusInternalServerError
+		if aoc.IsPartial(err) { // the best found before the request was canceled
+			res.Answer = &answer
+		}
+	default:
+		res.Answer = &answer
+	}
+
+	writeJSON(w, status, res)
+}
+
+// animationHandler solves a part while recording it, and responds with the
+// animated SVG. ?every=n keeps every nth frame.
+func (d dashboard) animationHandler(w http.ResponseWriter, r *http.Request) {
+	req, err := d.readSolveRequest(w, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	every := 1
+	if v := r.URL.Query().Get("every"); v != "" {
+		if every, err = strconv.Atoi(v); err != nil || every < 1 {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid every %q", v))
+			return
+		}
+	}
+
+	s, err := req.puzzle.Load(strings.NewReader(req.in), req.params)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rec, ok := s.(anim.Recordable)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("day %d has no simulation to animate", req.puzzle.Day))
+		return
+	}
+	recording := &anim.Recording{Every: every}
+	rec.Record(recording)
+
+	if _, err := aoc.SolvePartContext(r.Context(), s, req.part); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/svg+xml")
+	recording.WriteSVG(w)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+func post(t *testing.T, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	w := httptest.NewRecorder()
+	newDashboard(aoc.DefaultYear).ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	return w
+}
+
+func decode(t *testing.T, w *httptest.ResponseRecorder) solveResult {
+	t.Helper()
+	var res solveResult
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestDays(t *testing.T) {
+	w := httptest.NewRecorder()
+	newDashboard(aoc.DefaultYear).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/days", nil))
+
+	var days []dayInfo
+	if err := json.NewDecoder(w.Body).Decode(&days); err != nil {
+		t.Fatal(err)
+	}
+	if len(days) != 25 {
+		t.Fatalf("got %d days, want 25", len(days))
+	}
+	if !days[13].Recordable || days[0].Recordable {
+		t.Errorf("day 14 should be recordable and day 1 not")
+	}
+}
+
+func TestSolve(t *testing.T) {
+	w := post(t, "/api/days/1/parts/1?input=example", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	if res := decode(t, w); res.Answer == nil || res.Answer.String() != "24000" || res.Input != "example" {
+		t.Errorf("day 1 example part 1 = %+v, want 24000", res)
+	}
+}
+
+func TestSolveYear(t *testing.T) {
+	w := httptest.NewRecorder()
+	newDashboard(2015).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/days/1/parts/1", nil))
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "no solution for day 1 of 2015") {
+		t.Errorf("2015 day 1 = %d %s, want no solution", w.Code, w.Body)
+	}
+}
+
+func TestSolveUpload(t *testing.T) {
+	res := decode(t, post(t, "/api/days/1/parts/2", "1\n\n2\n\n3\n\n4\n"))
+	if res.Answer == nil || res.Answer.String() != "9" || res.Input != "upload" {
+		t.Errorf("day 1 upload part 2 = %+v, want 9", res)
+	}
+}
+
+func TestSolveVisual(t *testing.T) {
+	res := decode(t, post(t, "/api/days/14/parts/1?input=example&visual=1", ""))
+	if res.Frames != 24 || len(res.Visual) == 0 {
+		t.Errorf("day 14 example drew %d frames, last %q, want 24", res.Frames, res.Visual)
+	}
+
+	// drawing is only done when asked for
+	res = decode(t, post(t, "/api/days/14/parts/1?input=example", ""))
+	if res.Frames != 0 || len(res.Visual) != 0 || res.Answer == nil {
+		t.Errorf("day 14 example without visual drew %d frames, want none", res.Frames)
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	tests := []struct {
+		path, body string
+		status     int
+	}{
+		{"/api/days/26/parts/1", "", http.StatusBadRequest},
+		{"/api/days/x/parts/1", "", http.StatusBadRequest},
+		{"/api/days/1/parts/3", "", http.StatusBadRequest},
+		{"/api/days/1/parts/1?input=other", "", http.StatusBadRequest},
+		{"/api/days/1/parts/1?p=bad", "", http.StatusBadRequest},
+		{"/api/days/1/parts/1?visual=maybe", "", http.StatusBadRequest},
+		{"/api/days/1/parts/1", "1\nx\n", http.StatusUnprocessableEntity},
+		{"/api/days/25/parts/2?input=example", "", http.StatusNotFound},
+		{"/api/days/1/parts/1/animation.svg", "", http.StatusNotFound},
+	}
+
+	for _, tc := range tests {
+		if w := post(t, tc.path, tc.body); w.Code != tc.status {
+			t.Errorf("POST %s = %d, want %d: %s", tc.path, w.Code, tc.status, w.Body)
+		}
+	}
+}
+
+func TestAnimation(t *testing.T) {
+	w := post(t, "/api/days/14/parts/1/animation.svg?input=example&every=5", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "image/svg+xml" {
+		t.Errorf("Content-Type = %q, want image/svg+xml", ct)
+	}
+	if !strings.Contains(w.Body.String(), "<svg") {
+		t.Errorf("body isn't an SVG")
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/site"
+)
+
+type submitOptions struct {
+	year, day, part int
+	answer          string // to submit instead of solving the puzzle
+	input           string // path of the input to answer instead of the embedded one
+	manifest        string // path of the answers manifest
+	dryRun          bool
+}
+
+func submitCmd(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	var opts submitOptions
+	fs.IntVar(&opts.year, "year", aoc.DefaultYear, "year of the puzzle")
+	fs.IntVar(&opts.day, "day", 0, "day of the puzzle (1-25)")
+	fs.IntVar(&opts.part, "part", 0, "part to answer (1 or 2)")
+	fs.StringVar(&opts.answer, "answer", "", "submit `answer` instead of solving the puzzle, such as the letters day 10 draws")
+	fs.StringVar(&opts.input, "input", "", "answer the input at `path` instead of the embedded input, leaving the manifest alone")
+	fs.StringVar(&opts.manifest, "manifest", "", "`path` to the answers manifest (default answers.json, or YEAR/answers.json for other years)")
+	fs.BoolVar(&opts.dryRun, "n", false, "check the answer against the manifest, but don't submit it")
+	fs.Parse(args)
+
+	if opts.manifest == "" {
+		opts.manifest = manifestPath(opts.year)
+	}
+
+	session, err := site.Session()
+	if err != nil {
+		return err
+	}
+	c, err := site.New(session)
+	if err != nil {
+		return err
+	}
+
+	return submit(context.Background(), os.Stdout, c, opts)
+}
+
+// submit submits the answer to a part of the puzzle input, unless the
+// manifest shows it's wrong, and records what the site said of it there: the
+// answer if it was right, or the attempt if not. The manifest only holds the
+// answers of the embedded input, so an answer for the input at opts.input is
+// neither checked against it nor recorded in it.
+func submit(ctx context.Context, w io.Writer, c *site.Client, opts submitOptions) error {
+	if opts.part != 1 && opts.part != 2 {
+		return fmt.Errorf("invalid part %d", opts.part)
+	}
+
+	a, err := answerToSubmit(opts)
+	if err != nil {
+		return err
+	}
+
+	m := aoc.Manifest{}
+	if opts.input == "" {
+		m, err = aoc.ReadManifest(opts.manifest)
+		if errors.Is(err, os.ErrNotExist) {
+			m = aoc.Manifest{}
+		} else if err != nil {
+			return err
+		}
+	}
+	d := m[opts.day]
+	if d == nil {
+		d = &aoc.DayAnswers{}
+	}
+
+	if want, ok := d.Input.Part(opts.part); ok && want == a {
+		fmt.Fprintf(w, "Day %d Part %d: %s is already known to be right\n", opts.day, opts.part, a)
+		return nil
+	}
+	if err := d.Rejects(opts.part, a); err != nil {
+		return fmt.Errorf("not submitting %s: %w", a, err)
+	}
+	if opts.dryRun {
+		fmt.Fprintf(w, "Day %d Part %d: would submit %s\n", opts.day, opts.part, a)
+		return nil
+	}
+
+	r, err := c.Submit(ctx, opts.year, opts.day, opts.part, a.String())
+	if err != nil {
+		return err
+	}
+
+	var wait string
+	if r.Wait > 0 {
+		wait = fmt.Sprintf("; wait %s before answering again", r.Wait)
+	}
+
+	switch r.Verdict {
+	case site.Correct:
+		d.Input.SetPart(opts.part, a)
+		if err := record(m, d, opts); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "Day %d Part %d: %s is right\n", opts.day, opts.part, a)
+		return nil
+	case site.Wrong, site.TooHigh, site.TooLow:
+		attempt := aoc.Attempt{Part: opts.part, Answer: a}
+		if r.Verdict == site.TooHigh {
+			attempt.Hint = aoc.HintTooHigh
+		} else if r.Verdict == site.TooLow {
+			attempt.Hint = aoc.HintTooLow
+		}
+		d.Wrong = append(d.Wrong, attempt)
+		if err := record(m, d, opts); err != nil {
+			return err
+		}
+		return fmt.Errorf("%s is wrong (%s)%s", a, r.Verdict, wait)
+	case site.TooSoon:
+		return fmt.Errorf("answered too recently%s", wait)
+	default:
+		return fmt.Errorf("the site said: %s", r.Message)
+	}
+}
+
+// record writes the day's answers d to the manifest m, if the answer was for
+// the embedded input.
+func record(m aoc.Manifest, d *aoc.DayAnswers, opts submitOptions) error {
+	if opts.input != "" {
+		return nil
+	}
+	m[opts.day] = d
+	return m.WriteFile(opts.manifest)
+}
+
+// answerToSubmit returns the answer given with -answer, or else solves the
+// part.
+func answerToSubmit(opts submitOptions) (aoc.Answer, error) {
+	if opts.answer != "" {
+		if n, err := strconv.Atoi(opts.answer); err == nil {
+			return aoc.Int(n), nil
+		}
+		return aoc.Text(opts.answer), nil
+	}
+
+	puzzle, ok := aoc.LookupYear(opts.year, opts.day)
+	if !ok {
+		return aoc.Answer{}, fmt.Errorf("no solution for day %d of %d", opts.day, opts.year)
+	}
+
+	var s aoc.Solver
+	var err error
+	if opts.input != "" {
+		s, err = puzzle.LoadFile(opts.input, puzzle.Params)
+	} else {
+		s, err = puzzle.Load(strings.NewReader(puzzle.Input), puzzle.Params)
+	}
+	if err != nil {
+		return aoc.Answer{}, fmt.Errorf("day %d: %w", opts.day, err)
+	}
+
+	a, err := aoc.SolvePart(s, opts.part)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	if strings.Contains(a.String(), "\n") {
+		return aoc.Answer{}, fmt.Errorf("the answer is a picture:\n%s\nsubmit what it reads with -answer", a)
+	}
+
+	return a, nil
+}
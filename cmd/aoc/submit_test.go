@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/site"
+)
+
+// newSubmitSite returns a fake site that knows part 1 of day 1 is 42, and
+// counts the answers submitted to it.
+func newSubmitSite(submitted *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*submitted++
+		switch n := r.PostFormValue("answer"); {
+		case n == "42":
+			fmt.Fprint(w, "<article><p>That's the right answer!</p></article>")
+		case len(n) > 2:
+			fmt.Fprint(w, "<article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again.</p></article>")
+		default:
+			fmt.Fprint(w, "<article><p>That's not the right answer; your answer is too low.</p></article>")
+		}
+	}))
+}
+
+func TestSubmit(t *testing.T) {
+	submitted := 0
+	srv := newSubmitSite(&submitted)
+	defer srv.Close()
+
+	c := &site.Client{BaseURL: srv.URL, Session: "secret"}
+	manifest := filepath.Join(t.TempDir(), "answers.json")
+	opts := func(answer string) submitOptions {
+		return submitOptions{year: 2022, day: 1, part: 1, answer: answer, manifest: manifest}
+	}
+
+	tests := []struct {
+		answer    string
+		submitted int // requests so far
+		err       string
+	}{
+		{"100", 1, "100 is wrong (too high); wait 1m0s"},
+		{"200", 1, "200 is too high, as 100 already was"},
+		{"7", 2, "7 is wrong (too low)"},
+		{"5", 2, "5 is too low, as 7 already was"},
+		{"42", 3, ""},
+		{"42", 3, ""}, // already known
+		{"43", 3, "the answer is known to be 42"},
+	}
+	for _, tt := range tests {
+		err := submit(context.Background(), &bytes.Buffer{}, c, opts(tt.answer))
+		if tt.err == "" && err != nil || tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("submit(%s) = %v, want %q", tt.answer, err, tt.err)
+		}
+		if submitted != tt.submitted {
+			t.Errorf("after submit(%s), %d submitted, want %d", tt.answer, submitted, tt.submitted)
+		}
+	}
+
+	m, err := aoc.ReadManifest(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := m[1].Input.Part(1); !ok || a != aoc.Int(42) {
+		t.Errorf("manifest has part 1 = %v, %v, want 42", a, ok)
+	}
+	if len(m[1].Wrong) != 2 {
+		t.Errorf("manifest has wrong attempts %v, want 100 and 7", m[1].Wrong)
+	}
+}
+
+func TestSubmitInput(t *testing.T) {
+	submitted := 0
+	srv := newSubmitSite(&submitted)
+	defer srv.Close()
+	c := &site.Client{BaseURL: srv.URL, Session: "secret"}
+
+	// the manifest has the embedded input's answer, and a wrong one too low
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "answers.json")
+	d := &aoc.DayAnswers{Wrong: []aoc.Attempt{{Part: 1, Answer: aoc.Int(50), Hint: aoc.HintTooLow}}}
+	d.Input.SetPart(1, aoc.Int(69836))
+	if err := (aoc.Manifest{1: d}).WriteFile(manifest); err != nil {
+		t.Fatal(err)
+	}
+	before, _ := os.ReadFile(manifest)
+
+	// another input, whose answer the manifest would refuse as too low
+	input := filepath.Join(dir, "input.txt")
+	os.WriteFile(input, []byte("20\n22\n\n7\n"), 0o644)
+
+	opts := submitOptions{year: 2022, day: 1, part: 1, input: input, manifest: manifest}
+	if err := submit(context.Background(), &bytes.Buffer{}, c, opts); err != nil || submitted != 1 {
+		t.Errorf("submit -input = %v after %d submitted, want 42 submitted and right", err, submitted)
+	}
+	if after, _ := os.ReadFile(manifest); !bytes.Equal(after, before) {
+		t.Errorf("submit -input changed the manifest to\n%s", after)
+	}
+}
+
+func TestAnswerToSubmit(t *testing.T) {
+	if a, err := answerToSubmit(submitOptions{year: 2022, day: 1, part: 1}); err != nil || a != aoc.Int(69836) {
+		t.Errorf("answerToSubmit day 1 = %v, %v, want 69836", a, err)
+	}
+	if _, err := answerToSubmit(submitOptions{year: 2022, day: 10, part: 2}); err == nil || !strings.Contains(err.Error(), "-answer") {
+		t.Errorf("answerToSubmit day 10 part 2 = %v, want an error asking for -answer", err)
+	}
+	if a, _ := answerToSubmit(submitOptions{answer: "EHPZPJGL"}); a != aoc.Text("EHPZPJGL") {
+		t.Errorf("answerToSubmit -answer EHPZPJGL = %v", a)
+	}
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// check is the outcome of verifying one part of one input against the
+// manifest.
+type check struct {
+	day, part int
+	input     string // "example" or "input"
+	want, got aoc.Answer
+	known     bool   // whether want came from the manifest
+	skip      string // why the manifest says to skip the part
+	err       error
+	elapsed   time.Duration
+}
+
+func (c check) status() string {
+	switch {
+	case c.skip != "":
+		return "skip"
+	case c.err != nil:
+		return "ERROR"
+	case !c.known:
+		return "new"
+	case c.want == c.got:
+		return "ok"
+	default:
+		return "FAIL"
+	}
+}
+
+// manifestPath returns where the answers manifest of year is by default.
+func manifestPath(year int) string {
+	if year == aoc.DefaultYear {
+		return "answers.json"
+	}
+	return path.Join(strconv.Itoa(year), "answers.json")
+}
+
+// verifyOptions are the flags of aoc verify.
+type verifyOptions struct {
+	year, day int
+	manifest  string
+	kinds     []string // the inputs to verify, "example" and "input"
+	record    bool
+	timeout   time.Duration // per part, or 0 for no limit
+}
+
+func verifyCmd(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	var opts verifyOptions
+	fs.IntVar(&opts.year, "year", aoc.DefaultYear, "year of the puzzles to verify")
+	fs.IntVar(&opts.day, "day", 0, "only verify this day (default all)")
+	fs.StringVar(&opts.manifest, "manifest", "", "`path` to the expected answers manifest (default answers.json, or YEAR/answers.json for other years)")
+	inputs := fs.String("inputs", "all", "which inputs to verify: example, input or all")
+	fs.BoolVar(&opts.record, "record", false, "solve parts missing from the manifest and record their answers")
+	fs.DurationVar(&opts.timeout, "timeout", 0, "fail a part that takes longer than `duration` (0 for no limit)")
+	fs.Parse(args)
+
+	switch *inputs {
+	case "example", "input":
+		opts.kinds = []string{*inputs}
+	case "all":
+		opts.kinds = []string{"example", "input"}
+	default:
+		return fmt.Errorf("invalid -inputs %q", *inputs)
+	}
+
+	if opts.manifest == "" {
+		opts.manifest = manifestPath(opts.year)
+	}
+
+	return verify(os.Stdout, opts)
+}
+
+// verify checks the days' answers against the manifest, writing a table of
+// the checks to w, and fails if any of them did.
+func verify(w io.Writer, opts verifyOptions) error {
+	m, err := aoc.ReadManifest(opts.manifest)
+	if errors.Is(err, os.ErrNotExist) && opts.record {
+		m = aoc.Manifest{}
+	} else if err != nil {
+		return err
+	}
+
+	var checks []check
+	for _, puzzle := range aoc.PuzzlesYear(opts.year) {
+		if opts.day != 0 && puzzle.Day != opts.day {
+			continue
+		}
+
+		answers := m[puzzle.Day]
+		if answers == nil {
+			answers = &aoc.DayAnswers{}
+		}
+
+		for _, kind := range opts.kinds {
+			in, params, expected := puzzle.Input, puzzle.Params, &answers.Input
+			if kind == "example" {
+				in, params, expected = puzzle.Example, puzzle.ExampleParams, &answers.Example
+			}
+
+			checks = append(checks, verifyInput(puzzle, kind, in, params, expected, opts)...)
+		}
+
+		if opts.record && (answers.Example != aoc.Expected{} || answers.Input != aoc.Expected{}) {
+			m[puzzle.Day] = answers
+		}
+	}
+
+	failed := printChecks(w, checks)
+
+	if opts.record {
+		if err := m.WriteFile(opts.manifest); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(checks))
+	}
+
+	return nil
+}
+
+// verifyInput solves each part of one input that has an expected answer, or
+// every part when recording. Newly solved answers are recorded in expected.
+func verifyInput(puzzle aoc.Puzzle, kind, in string, params aoc.Params, expected *aoc.Expected, opts verifyOptions) []check {
+	var checks []check
+
+	var s aoc.Solver
+	var parseErr error
+	for part := 1; part <= 2; part++ {
+		want, known := expected.Part(part)
+		if !known && !opts.record {
+			continue
+		}
+
+		c := check{day: puzzle.Day, part: part, input: kind, want: want, known: known}
+		if why, ok := expected.Skipped(part); ok {
+			c.skip = why
+			checks = append(checks, c)
+			continue
+		}
+
+		if s == nil && parseErr == nil {
+			s, parseErr = puzzle.Load(strings.NewReader(in), params)
+		}
+		if parseErr != nil {
+			c.err = parseErr
+			checks = append(checks, c)
+			continue
+		}
+
+		start := time.Now()
+		c.got, c.err = solve(s, part, opts.timeout)
+		c.elapsed = time.Since(start)
+		if errors.Is(c.err, context.DeadlineExceeded) {
+			c.err = fmt.Errorf("gave up after %s: %w", opts.timeout, c.err)
+		}
+
+		if errors.Is(c.err, aoc.ErrNoPart) && !known {
+			continue
+		}
+		if c.err == nil && !known {
+			expected.SetPart(part, c.got)
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks
+}
+
+// printChecks writes a table of checks followed by the details of any
+// failures, and returns the number of failures.
+func printChecks(w io.Writer, checks []check) int {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DAY\tPART\tINPUT\tSTATUS\tTIME")
+	for _, c := range checks {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\n", c.day, c.part, c.input, c.status(), c.elapsed.Round(time.Microsecond))
+	}
+	tw.Flush()
+
+	failed := 0
+	for _, c := range checks {
+		switch c.status() {
+		case "skip":
+			fmt.Fprintf(w, "\nday %d part %d %s: skipped, as %s\n", c.day, c.part, c.input, c.skip)
+			continue
+		case "ERROR":
+			fmt.Fprintf(w, "\nday %d part %d %s: %s\n", c.day, c.part, c.input, c.err)
+		case "FAIL":
+			fmt.Fprintf(w, "\nday %d part %d %s:\n%s", c.day, c.part, c.input, diff(c.want.String(), c.got.String()))
+		default:
+			continue
+		}
+		failed++
+	}
+
+	return failed
+}
+
+// diff returns a line by line comparison of want and got, so mismatches in
+// multi-line answers such as the day 10 CRT image are easy to spot.
+func diff(want, got string) string {
+	wl := strings.Split(strings.TrimRight(want, "\n"), "\n")
+	gl := strings.Split(strings.TrimRight(got, "\n"), "\n")
+
+	var b strings.Builder
+	for i := 0; i < len(wl) || i < len(gl); i++ {
+		var w, g string
+		if i < len(wl) {
+			w = wl[i]
+		}
+		if i < len(gl) {
+			g = gl[i]
+		}
+
+		if w == g {
+			fmt.Fprintf(&b, "  %s\n", w)
+			continue
+		}
+		fmt.Fprintf(&b, "- %s\n+ %s\n", w, g)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// writeManifest writes a manifest for day 1 to a temporary file: the example's
+// part 1 is right and part 2 wrong, the input's part 1 is skipped and part 2
+// missing.
+func writeManifest(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "answers.json")
+	if err := os.WriteFile(path, []byte(`{
+  "1": {
+    "example": {"part1": 24000, "part2": 1},
+    "input": {"part1": 69836, "skip1": "it's only a test"}
+  }
+}
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestVerify(t *testing.T) {
+	opts := verifyOptions{year: aoc.DefaultYear, day: 1, manifest: writeManifest(t), kinds: []string{"example", "input"}}
+
+	var b bytes.Buffer
+	err := verify(&b, opts)
+	if err == nil || err.Error() != "1 of 3 checks failed" {
+		t.Errorf("verify = %v, want 1 of 3 checks failed", err)
+	}
+
+	out := b.String()
+	for _, want := range []string{
+		"1    1     example  ok",
+		"1    2     example  FAIL",
+		"1    1     input    skip",
+		"day 1 part 2 example:\n- 1\n+ 45000\n",
+		"day 1 part 1 input: skipped, as it's only a test",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output =\n%s\nwant it to have %q", out, want)
+		}
+	}
+	if strings.Contains(out, "1    2     input") {
+		t.Errorf("output =\n%s\nwant no check of the missing input part 2", out)
+	}
+}
+
+func TestVerifyRecord(t *testing.T) {
+	opts := verifyOptions{year: aoc.DefaultYear, day: 1, manifest: writeManifest(t), kinds: []string{"input"}, record: true}
+
+	var b bytes.Buffer
+	if err := verify(&b, opts); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), "1    2     input  new") {
+		t.Errorf("output =\n%s\nwant the missing part 2 solved as new", &b)
+	}
+
+	m, err := aoc.ReadManifest(opts.manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := m[1].Input.Part(2); !ok || a != aoc.Int(207968) {
+		t.Errorf("recorded input part 2 = %v, %v, want 207968", a, ok)
+	}
+	if why, ok := m[1].Input.Skipped(1); !ok || why != "it's only a test" {
+		t.Errorf("recording lost the skip of part 1: %q, %v", why, ok)
+	}
+}
+
+func TestVerifyTimeout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	os.WriteFile(path, []byte(`{"19": {"example": {"part1": 33}}}`), 0o644)
+	opts := verifyOptions{year: aoc.DefaultYear, day: 19, manifest: path, kinds: []string{"example"}, timeout: time.Millisecond}
+
+	var b bytes.Buffer
+	if err := verify(&b, opts); err == nil {
+		t.Errorf("verify with a 1ms timeout succeeded")
+	}
+	if !strings.Contains(b.String(), "day 19 part 1 example: gave up after 1ms") {
+		t.Errorf("output =\n%s\nwant day 19 to give up", &b)
+	}
+}
+
+func TestCheckStatus(t *testing.T) {
+	for _, tc := range []struct {
+		c    check
+		want string
+	}{
+		{check{known: true, want: aoc.Int(1), got: aoc.Int(1)}, "ok"},
+		{check{known: true, want: aoc.Int(1), got: aoc.Int(2)}, "FAIL"},
+		{check{got: aoc.Int(2)}, "new"},
+		{check{known: true, err: os.ErrNotExist}, "ERROR"},
+		{check{known: true, skip: "slow", err: os.ErrNotExist}, "skip"},
+	} {
+		if got := tc.c.status(); got != tc.want {
+			t.Errorf("%+v status = %s, want %s", tc.c, got, tc.want)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	got := diff("##..\n.##.\n", "##..\n.#..\n")
+	if want := "  ##..\n- .##.\n+ .#..\n"; got != want {
+		t.Errorf("diff =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,10 @@
+// Command day1 prints the answers to day 1, for the input in the file named by
+// its argument if there is one.
+package main
+
+import (
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	_ "github.com/nickshine/adventofcode2022/day1"
+)
+
+func main() { daycmd.Main(1, false) }
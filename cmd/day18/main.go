@@ -0,0 +1,10 @@
+// Command day18 prints the answers to day 18, for the input in the file named by
+// its argument if there is one, after the answers to its example.
+package main
+
+import (
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	_ "github.com/nickshine/adventofcode2022/day18"
+)
+
+func main() { daycmd.Main(18, true) }
@@ -0,0 +1,10 @@
+// Command day19 prints the answers to day 19, for the input in the file named by
+// its argument if there is one.
+package main
+
+import (
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	_ "github.com/nickshine/adventofcode2022/day19"
+)
+
+func main() { daycmd.Main(19, false) }
@@ -0,0 +1,10 @@
+// Command day2 prints the answers to day 2, for the input in the file named by
+// its argument if there is one.
+package main
+
+import (
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	_ "github.com/nickshine/adventofcode2022/day2"
+)
+
+func main() { daycmd.Main(2, false) }
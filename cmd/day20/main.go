@@ -0,0 +1,10 @@
+// Command day20 prints the answers to day 20, for the input in the file named by
+// its argument if there is one, after the answers to its example.
+package main
+
+import (
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	_ "github.com/nickshine/adventofcode2022/day20"
+)
+
+func main() { daycmd.Main(20, true) }
@@ -0,0 +1,10 @@
+// Command day21 prints the answers to day 21, for the input in the file named by
+// its argument if there is one, after the answers to its example.
+package main
+
+import (
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	_ "github.com/nickshine/adventofcode2022/day21"
+)
+
+func main() { daycmd.Main(21, true) }
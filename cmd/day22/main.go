@@ -0,0 +1,10 @@
+// Command day22 prints the answers to day 22, for the input in the file named by
+// its argument if there is one, after the answers to its example.
+package main
+
+import (
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	_ "github.com/nickshine/adventofcode2022/day22"
+)
+
+func main() { daycmd.Main(22, true) }
@@ -0,0 +1,10 @@
+// Command day3 prints the answers to day 3, for the input in the file named by
+// its argument if there is one.
+package main
+
+import (
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	_ "github.com/nickshine/adventofcode2022/day3"
+)
+
+func main() { daycmd.Main(3, false) }
@@ -0,0 +1,10 @@
+// Command day6 prints the answers to day 6, for the input in the file named by
+// its argument if there is one.
+package main
+
+import (
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	_ "github.com/nickshine/adventofcode2022/day6"
+)
+
+func main() { daycmd.Main(6, false) }
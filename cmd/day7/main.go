@@ -0,0 +1,42 @@
+// Command day7 prints the answers to day 7, for the input in the file named by
+// its argument if there is one. With -tree, it prints the directory tree the
+// terminal output explores first.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/cmd/internal/daycmd"
+	"github.com/nickshine/adventofcode2022/day7"
+)
+
+func main() {
+	tree := flag.Bool("tree", false, "print the directory tree before the answers")
+	flag.Parse()
+
+	if err := run(os.Stdout, *tree, flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "day7: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, tree bool, args []string) error {
+	if tree {
+		p, _ := aoc.Lookup(7)
+		in, err := daycmd.Input(p, args)
+		if err != nil {
+			return err
+		}
+		root, err := day7.BuildFS(in)
+		if err != nil {
+			return err
+		}
+		root.Display(w, 0)
+	}
+
+	return daycmd.Print(w, 7, false, args)
+}
@@ -0,0 +1,88 @@
+// Package daycmd is the shared body of the cmd/dayN programs, which print a
+// day's answers as they did before the aoc command solved every day.
+package daycmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// Main prints the answers to both parts of day for its embedded input, or the
+// input in the file named by the first argument, and with examples, for its
+// example first. It exits non-zero if a part fails.
+func Main(day int, examples bool) {
+	if err := Print(os.Stdout, day, examples, os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "day%d: %v\n", day, err)
+		os.Exit(1)
+	}
+}
+
+// Print writes what Main prints to w.
+func Print(w io.Writer, day int, examples bool, args []string) error {
+	p, ok := aoc.Lookup(day)
+	if !ok {
+		return fmt.Errorf("no solution for day %d", day)
+	}
+
+	in, err := Input(p, args)
+	if err != nil {
+		return err
+	}
+
+	var ex aoc.Solver
+	if examples {
+		var err error
+		if ex, err = p.Load(strings.NewReader(p.Example), p.ExampleParams); err != nil {
+			return fmt.Errorf("example: %w", err)
+		}
+	}
+	s, err := p.Load(strings.NewReader(in), p.Params)
+	if err != nil {
+		return err
+	}
+
+	for n := 1; n <= 2; n++ {
+		if ex != nil {
+			if err := printPart(w, fmt.Sprintf("Part %d example", n), ex, n); err != nil {
+				return err
+			}
+		}
+		if err := printPart(w, fmt.Sprintf("Part %d", n), s, n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Input returns the input in the file named by the first of args, or p's
+// embedded input if there are none.
+func Input(p aoc.Puzzle, args []string) (string, error) {
+	if len(args) == 0 {
+		return p.Input, nil
+	}
+	return aoc.ReadInputFile(args[0])
+}
+
+// printPart writes the answer to part as "label: answer", with multi-line
+// answers, such as the day 10 CRT image, starting on the line below.
+func printPart(w io.Writer, label string, s aoc.Solver, part int) error {
+	a, err := aoc.SolvePart(s, part)
+	if errors.Is(err, aoc.ErrNoPart) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("%s: %w", strings.ToLower(label), err)
+	}
+
+	if text := a.String(); strings.Contains(text, "\n") {
+		fmt.Fprintf(w, "%s:\n%s\n", label, strings.TrimRight(text, "\n"))
+	} else {
+		fmt.Fprintf(w, "%s: %s\n", label, text)
+	}
+	return nil
+}
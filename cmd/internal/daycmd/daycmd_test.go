@@ -0,0 +1,34 @@
+package daycmd
+
+import (
+	"bytes"
+	"testing"
+
+	_ "github.com/nickshine/adventofcode2022/day25"
+	_ "github.com/nickshine/adventofcode2022/day4"
+)
+
+func TestPrint(t *testing.T) {
+	for _, tc := range []struct {
+		day      int
+		examples bool
+		args     []string
+		want     string
+	}{
+		{4, false, []string{"../../../day4/example.txt"}, "Part 1: 2\nPart 2: 4\n"},
+		// day 25 has no second part
+		{25, true, []string{"../../../day25/example.txt"}, "Part 1 example: 2=-1=0\nPart 1: 2=-1=0\n"},
+	} {
+		var b bytes.Buffer
+		if err := Print(&b, tc.day, tc.examples, tc.args); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != tc.want {
+			t.Errorf("day %d printed\n%s\nwant\n%s", tc.day, b.String(), tc.want)
+		}
+	}
+
+	if err := Print(&bytes.Buffer{}, 4, false, []string{"missing.txt"}); err == nil {
+		t.Error("Print of a missing input succeeded")
+	}
+}
@@ -0,0 +1,68 @@
+//go:build js && wasm
+
+// Command playground is the solvers compiled to WebAssembly for index.html,
+// which solves pasted puzzle inputs entirely in the browser. Build it, along
+// with the wasm_exec.js that loads it, with
+//
+//	GOOS=js GOARCH=wasm go generate ./cmd/playground
+//
+// then serve this directory, such as with aoc serve -playground cmd/playground.
+package main
+
+//go:generate sh -c "go build -o aoc.wasm . && cp \"$(go env GOROOT)/lib/wasm/wasm_exec.js\" ."
+
+import (
+	"encoding/json"
+	"syscall/js"
+
+	_ "github.com/nickshine/adventofcode2022/all"
+	"github.com/nickshine/adventofcode2022/playground"
+)
+
+func main() {
+	js.Global().Set("aoc", js.ValueOf(map[string]any{
+		"days":  js.FuncOf(days),
+		"solve": js.FuncOf(solve),
+	}))
+
+	// the functions are called for as long as the page is open
+	select {}
+}
+
+// days takes a year and returns its playground.Days as JSON.
+func days(this js.Value, args []js.Value) any {
+	if len(args) != 1 {
+		panic("days wants a year")
+	}
+	return marshal(playground.Days(args[0].Int()))
+}
+
+// solve takes the year, day, part, input, parameters and whether to draw, and
+// returns a promise of the playground.Result as JSON. The solving happens on
+// its own goroutine, as a function called from JavaScript mustn't block.
+func solve(this js.Value, args []js.Value) any {
+	if len(args) != 6 {
+		return js.Global().Get("Promise").Call("reject", "solve wants a year, day, part, input, parameters and whether to draw")
+	}
+	year, day, part := args[0].Int(), args[1].Int(), args[2].Int()
+	in, params, visual := args[3].String(), args[4].String(), args[5].Bool()
+
+	var executor js.Func
+	executor = js.FuncOf(func(this js.Value, fns []js.Value) any {
+		executor.Release()
+		resolve := fns[0]
+		go func() {
+			resolve.Invoke(marshal(playground.Solve(year, day, part, in, params, visual)))
+		}()
+		return nil
+	})
+	return js.Global().Get("Promise").New(executor)
+}
+
+func marshal(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
@@ -0,0 +1,53 @@
+// Package cycle finds where a simulation starts repeating itself, so that its
+// outcome after far too many steps to simulate, such as the trillion rocks of
+// day 17, can be worked out from the first few cycles.
+package cycle
+
+import "fmt"
+
+// Cycle is a simulation that, after a prefix of steps, repeats every period
+// steps, with the metric changing by delta each time round.
+type Cycle struct {
+	Prefix int // steps before the first cycle starts
+	Period int // steps in each cycle
+	Delta  int // change in the metric over each cycle
+
+	metrics []int // the metric after each step, up to the end of the first cycle
+}
+
+// Find steps a simulation until it reaches a state it has been in before.
+// step is called with n = 0, 1, 2 and so on, and returns the key of the state
+// after n steps, with the metric to extrapolate; step(0) is the starting
+// state. Two states with the same key must go on to repeat the same steps. It
+// is an error for no state to repeat within limit steps.
+func Find[K comparable](step func(n int) (key K, metric int), limit int) (*Cycle, error) {
+	seen := map[K]int{}
+	var metrics []int
+
+	for n := 0; n <= limit; n++ {
+		key, metric := step(n)
+		metrics = append(metrics, metric)
+
+		if first, ok := seen[key]; ok {
+			return &Cycle{
+				Prefix:  first,
+				Period:  n - first,
+				Delta:   metric - metrics[first],
+				metrics: metrics,
+			}, nil
+		}
+		seen[key] = n
+	}
+
+	return nil, fmt.Errorf("no cycle within %d steps", limit)
+}
+
+// At returns the metric after n steps.
+func (c *Cycle) At(n int) int {
+	if n < len(c.metrics) {
+		return c.metrics[n]
+	}
+
+	cycles, rem := (n-c.Prefix)/c.Period, (n-c.Prefix)%c.Period
+	return c.metrics[c.Prefix+rem] + cycles*c.Delta
+}
@@ -0,0 +1,33 @@
+package cycle
+
+import "testing"
+
+func TestFind(t *testing.T) {
+	// 0 1 2 then 3 4 5 6 repeating, gaining 10 per step
+	state := func(n int) (int, int) {
+		if n < 3 {
+			return n, 10 * n
+		}
+		return 3 + (n-3)%4, 10 * n
+	}
+
+	c, err := Find(state, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Prefix != 3 || c.Period != 4 || c.Delta != 40 {
+		t.Errorf("Find = prefix %d, period %d, delta %d, want 3, 4, 40", c.Prefix, c.Period, c.Delta)
+	}
+
+	for _, n := range []int{0, 2, 5, 7, 8, 1000, 1000000000001} {
+		if got := c.At(n); got != 10*n {
+			t.Errorf("At(%d) = %d, want %d", n, got, 10*n)
+		}
+	}
+}
+
+func TestFindNoCycle(t *testing.T) {
+	if _, err := Find(func(n int) (int, int) { return n, n }, 50); err == nil {
+		t.Errorf("Find of a sequence that never repeats succeeded")
+	}
+}
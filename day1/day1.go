@@ -0,0 +1,71 @@
+package day1
+
+import (
+	_ "embed"
+	"sort"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     1,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+type solver struct {
+	totals []int // calories carried by each elf, most first
+}
+
+func (s *solver) Parse(in string) error {
+	elves := aoc.Blocks(in)
+	if len(elves) == 0 {
+		return aoc.EmptyError()
+	}
+
+	for _, elf := range elves {
+		total := 0
+		for _, l := range elf {
+			calories, err := l.Atoi(strings.TrimSpace(l.Text))
+			if err != nil {
+				return err
+			}
+			total += calories
+		}
+		s.totals = append(s.totals, total)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(s.totals)))
+
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(s.totals[0]), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	sum := 0
+	top := s.totals
+	if len(top) > 3 {
+		top = top[:3]
+	}
+
+	for _, t := range top {
+		sum += t
+	}
+
+	return aoc.Int(sum), nil
+}
@@ -0,0 +1,25 @@
+package day1
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 1, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(24000), Part2: aoctest.Int(45000)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 1, []aoctest.BadInput{
+		{Name: "not a number", Input: "1000\n2000\n\nabc\n", Line: 4, Column: 1},
+		{Name: "empty", Input: "", Line: 1, Column: 0},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 1) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 1, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 1, 2) }
@@ -0,0 +1,154 @@
+package day10
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example2.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     10,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+func checkCycle(cycle, x int, cycles map[int]int) {
+	switch cycle {
+	case 20, 60, 100, 140, 180, 220:
+		cycles[cycle] = x
+	}
+}
+
+func display(w io.Writer, cycle, x int) {
+	// the CRT is 40x6; the last addx can tick past the final pixel
+	if cycle >= 240 {
+		return
+	}
+
+	pos := cycle % 40
+
+	switch pos {
+	case x - 1, x, x + 1:
+		fmt.Fprintf(w, "#")
+	default:
+		fmt.Fprintf(w, " ")
+	}
+
+	if pos == 39 {
+		fmt.Fprintf(w, "\n")
+	}
+
+}
+
+// instruction is a noop, or an addx of v.
+type instruction struct {
+	op string
+	v  int
+}
+
+func parseProgram(in string) ([]instruction, error) {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return nil, aoc.EmptyError()
+	}
+
+	program := make([]instruction, 0, len(lines))
+	for _, l := range lines {
+		parts := l.Fields()
+
+		switch {
+		case len(parts) == 1 && parts[0].Text == "noop":
+			program = append(program, instruction{op: "noop"})
+		case len(parts) == 2 && parts[0].Text == "addx":
+			val, err := l.AtoiAt(parts[1].Offset, parts[1].Text)
+			if err != nil {
+				return nil, err
+			}
+			program = append(program, instruction{op: "addx", v: val})
+		default:
+			return nil, l.Error(l.Text, errors.New("expected noop or addx V"))
+		}
+	}
+
+	return program, nil
+}
+
+// run executes the program, returning the sum of the signal strengths and the
+// image drawn on the CRT. If rec is set, it is sent the CRT after every cycle.
+func run(program []instruction, rec anim.Recorder) (int, string) {
+	cycles := map[int]int{}
+	var crt strings.Builder
+
+	draw := func(cycle, x int) {
+		display(&crt, cycle, x)
+		if rec != nil && cycle < 240 {
+			rec.Frame(anim.Capture(func(w io.Writer) { io.WriteString(w, crt.String()) }))
+		}
+	}
+
+	x, cycle := 1, 1
+	draw(0, x)
+
+	for _, inst := range program {
+		draw(cycle, x)
+		cycle++
+		checkCycle(cycle, x, cycles)
+
+		if inst.op == "addx" {
+			x += inst.v
+			draw(cycle, x)
+			cycle++
+			checkCycle(cycle, x, cycles)
+		}
+	}
+
+	var sum int
+	for c, x := range cycles {
+		sum += c * x
+	}
+
+	return sum, crt.String()
+}
+
+type solver struct {
+	program []instruction
+
+	rec anim.Recorder
+}
+
+func (s *solver) Record(r anim.Recorder) {
+	s.rec = r
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.program, err = parseProgram(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	sum, _ := run(s.program, nil)
+	return aoc.Int(sum), nil
+}
+
+// Part2 returns the image drawn on the CRT.
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, crt := run(s.program, s.rec)
+	return aoc.Text(crt), nil
+}
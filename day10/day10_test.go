@@ -0,0 +1,33 @@
+package day10
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+const exampleCRT = `##  ##  ##  ##  ##  ##  ##  ##  ##  ##  
+###   ###   ###   ###   ###   ###   ### 
+####    ####    ####    ####    ####    
+#####     #####     #####     #####     
+######      ######      ######      ####
+#######       #######       #######     
+`
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 10, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(13140), Part2: aoctest.Text(exampleCRT)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 10, []aoctest.BadInput{
+		{Name: "missing value", Input: "noop\naddx\n", Line: 2, Column: 1},
+		{Name: "bad value", Input: "addx 1x\n", Line: 1, Column: 6},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 10) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 10, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 10, 2) }
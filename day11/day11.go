@@ -0,0 +1,262 @@
+package day11
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     11,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+type apply func(l, r int) int
+type op func(n int) int
+
+type monkey struct {
+	items          []int
+	count, divisor int
+	op             op
+	test           func(n int) bool
+	tID, fID       int
+}
+
+// parseOperation parses the operation "new = left operator right" on line ln,
+// where either operand may be old.
+func parseOperation(ln aoc.Line, left, operator, right aoc.Field) (out op, err error) {
+	var fn apply
+	var leftOld, rightOld bool
+
+	var l, r int
+	if left.Text == "old" {
+		leftOld = true
+	} else if l, err = ln.AtoiAt(left.Offset, left.Text); err != nil {
+		return nil, err
+	}
+
+	if right.Text == "old" {
+		rightOld = true
+	} else if r, err = ln.AtoiAt(right.Offset, right.Text); err != nil {
+		return nil, err
+	}
+
+	switch operator.Text {
+	case "*":
+		fn = func(l, r int) int {
+			return l * r
+		}
+
+	case "+":
+		fn = func(l, r int) int {
+			return l + r
+		}
+	case "-":
+		fn = func(l, r int) int {
+			return l - r
+		}
+	default:
+		return nil, ln.ErrorAt(operator.Offset, operator.Text, errors.New("expected an operator *, + or -"))
+	}
+
+	switch {
+	case leftOld && rightOld:
+		out = func(n int) int {
+			return fn(n, n)
+		}
+	case leftOld:
+		out = func(n int) int {
+			return fn(n, r)
+		}
+	case rightOld:
+		out = func(n int) int {
+			return fn(l, n)
+		}
+	default:
+		out = func(n int) int {
+			return fn(l, r)
+		}
+	}
+
+	return out, nil
+
+}
+
+func readMonkeys(in [][]aoc.Line) ([]*monkey, error) {
+	var monkeys []*monkey
+
+	for _, m := range in {
+		monkey := &monkey{}
+		for _, l := range m {
+			parts := l.Fields()
+			switch {
+			case parts[0].Text == "Monkey":
+			case parts[0].Text == "Starting" && len(parts) >= 2:
+				for _, s := range parts[2:] {
+					item, err := l.AtoiAt(s.Offset, strings.TrimSuffix(s.Text, ","))
+					if err != nil {
+						return nil, err
+					}
+					monkey.items = append(monkey.items, item)
+				}
+			case parts[0].Text == "Operation:" && len(parts) == 6:
+				var err error
+				monkey.op, err = parseOperation(l, parts[3], parts[4], parts[5])
+				if err != nil {
+					return nil, err
+				}
+			case parts[0].Text == "Test:" && len(parts) == 4:
+				divisor, err := l.AtoiAt(parts[3].Offset, parts[3].Text)
+				if err != nil {
+					return nil, err
+				}
+				if divisor <= 0 {
+					return nil, l.ErrorAt(parts[3].Offset, parts[3].Text, errors.New("divisor must be positive"))
+				}
+
+				monkey.divisor = divisor
+				monkey.test = func(n int) bool {
+					return n%divisor == 0
+				}
+			case parts[0].Text == "If" && len(parts) == 6:
+				id, err := l.AtoiAt(parts[5].Offset, parts[5].Text)
+				if err != nil {
+					return nil, err
+				}
+				if id < 0 || id >= len(in) {
+					return nil, l.ErrorAt(parts[5].Offset, parts[5].Text, fmt.Errorf("no monkey %d", id))
+				}
+
+				switch parts[1].Text {
+				case "true:":
+					monkey.tID = id
+				case "false:":
+					monkey.fID = id
+				default:
+					return nil, l.ErrorAt(parts[1].Offset, parts[1].Text, errors.New("expected true: or false:"))
+				}
+			default:
+				return nil, l.Error(strings.TrimSpace(l.Text), errors.New("unexpected line in monkey notes"))
+			}
+		}
+
+		if monkey.op == nil || monkey.test == nil {
+			return nil, m[0].Error(m[0].Text, errors.New("monkey has no operation or test"))
+		}
+		monkeys = append(monkeys, monkey)
+	}
+
+	switch len(monkeys) {
+	case 0:
+		return nil, aoc.EmptyError()
+	case 1:
+		return nil, in[0][0].Error("", errors.New("expected at least two monkeys"))
+	}
+
+	return monkeys, nil
+}
+
+// copyMonkeys returns a copy of monkeys that can be played without changing
+// the parsed input.
+func copyMonkeys(monkeys []*monkey) []*monkey {
+	out := make([]*monkey, len(monkeys))
+	for i, m := range monkeys {
+		c := *m
+		c.items = append([]int(nil), m.items...)
+		out[i] = &c
+	}
+
+	return out
+}
+
+func throw(m *monkey, item int) {
+	m.items = append(m.items, item)
+}
+
+func findCommon(monkeys []*monkey) int {
+	common := 1
+	for _, m := range monkeys {
+		common *= m.divisor
+	}
+
+	return common
+}
+
+func doRound(monkeys []*monkey, manage func(int) int) {
+
+	for _, m := range monkeys {
+		for _, item := range m.items {
+			m.count++
+			wl := manage(m.op(item))
+			if m.test(wl) {
+				throw(monkeys[m.tID], wl)
+			} else {
+				throw(monkeys[m.fID], wl)
+			}
+		}
+		// clear items
+		m.items = nil
+	}
+}
+
+func maxLevel(monkeys []*monkey) int {
+	counts := []int{}
+	for _, m := range monkeys {
+		counts = append(counts, m.count)
+	}
+
+	sort.Ints(counts)
+	return counts[len(counts)-1] * counts[len(counts)-2]
+}
+
+type solver struct {
+	monkeys []*monkey
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.monkeys, err = readMonkeys(aoc.Blocks(in))
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	monkeys := copyMonkeys(s.monkeys)
+	const rounds = 20
+	for n := 0; n < rounds; n++ {
+		doRound(monkeys, func(item int) int {
+			return item / 3
+		})
+	}
+
+	return aoc.Int(maxLevel(monkeys)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	monkeys := copyMonkeys(s.monkeys)
+	const rounds = 10000
+	divisor := findCommon(monkeys)
+
+	for n := 0; n < rounds; n++ {
+		doRound(monkeys, func(v int) int {
+			return v % divisor
+		})
+	}
+
+	return aoc.Int(maxLevel(monkeys)), nil
+}
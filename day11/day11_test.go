@@ -0,0 +1,25 @@
+package day11
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 11, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(10605), Part2: aoctest.Int(2713310158)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 11, []aoctest.BadInput{
+		{Name: "bad operator", Input: "Monkey 0:\n  Starting items: 79, 98\n  Operation: new = old * 19\n  Test: divisible by 23\n    If true: throw to monkey 1\n    If false: throw to monkey 1\n\nMonkey 1:\n  Starting items: 54\n  Operation: new = old ^ 6\n  Test: divisible by 19\n    If true: throw to monkey 0\n    If false: throw to monkey 0\n", Line: 10, Column: 24},
+		{Name: "no such monkey", Input: "Monkey 0:\n  Starting items: 79, 98\n  Operation: new = old * 19\n  Test: divisible by 23\n    If true: throw to monkey 5\n    If false: throw to monkey 1\n\nMonkey 1:\n  Starting items: 54\n  Operation: new = old * 6\n  Test: divisible by 19\n    If true: throw to monkey 0\n    If false: throw to monkey 0\n", Line: 5, Column: 30},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 11) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 11, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 11, 2) }
@@ -0,0 +1,122 @@
+package day12
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/graph"
+	"github.com/nickshine/adventofcode2022/grid"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     12,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+// heightMap is the elevation of each square, with the start and end squares
+// given their elevations a and z.
+type heightMap struct {
+	elevation  *grid.Grid[rune]
+	start, end grid.Point
+}
+
+func readMap(in string) (heightMap, error) {
+	var h heightMap
+	var err error
+	h.elevation, err = grid.Parse(in, func(r rune) (rune, error) {
+		if r != 'S' && r != 'E' && (r < 'a' || r > 'z') {
+			return 0, errors.New("expected an elevation a-z, S or E")
+		}
+		return r, nil
+	})
+	if err != nil {
+		return heightMap{}, err
+	}
+
+	var starts, ends int
+	h.elevation.Each(func(p grid.Point, r rune) {
+		switch r {
+		case 'S':
+			h.start = p
+			h.elevation.Set(p, 'a')
+			starts++
+		case 'E':
+			h.end = p
+			h.elevation.Set(p, 'z')
+			ends++
+		}
+	})
+
+	if starts != 1 || ends != 1 {
+		return heightMap{}, aoc.Lines(in)[0].Error("", fmt.Errorf("found %d start and %d end squares, want one of each", starts, ends))
+	}
+
+	return h, nil
+}
+
+// climbable returns the squares next to p that are at most one higher.
+func (h heightMap) climbable(p grid.Point) []grid.Point {
+	e := h.elevation.At(p)
+	var out []grid.Point
+	for _, n := range h.elevation.Neighbors(p, grid.Neighbors4) {
+		if h.elevation.At(n)-e <= 1 {
+			out = append(out, n)
+		}
+	}
+
+	return out
+}
+
+// climb returns the fewest steps from any of starts to the end square.
+func (h heightMap) climb(starts []grid.Point) (int, error) {
+	r := graph.BFS(starts, h.climbable, func(p grid.Point) bool {
+		return p == h.end
+	})
+	if !r.Found {
+		return 0, errors.New("no path to the end square")
+	}
+
+	steps, _ := r.Dist(h.end)
+	return steps, nil
+}
+
+type solver struct {
+	m heightMap
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.m, err = readMap(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	steps, err := s.m.climb([]grid.Point{s.m.start})
+	return aoc.Int(steps), err
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	var starts []grid.Point
+	s.m.elevation.Each(func(p grid.Point, e rune) {
+		if e == 'a' {
+			starts = append(starts, p)
+		}
+	})
+
+	steps, err := s.m.climb(starts)
+	return aoc.Int(steps), err
+}
@@ -0,0 +1,25 @@
+package day12
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 12, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(31), Part2: aoctest.Int(29)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 12, []aoctest.BadInput{
+		{Name: "bad elevation", Input: "Sab\ncdE\nxy!\n", Line: 3, Column: 3},
+		{Name: "no start", Input: "abc\n", Line: 1, Column: 0},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 12) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 12, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 12, 2) }
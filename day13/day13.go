@@ -0,0 +1,213 @@
+package day13
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     13,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+// Comparison is the result of comparing two packets.
+type Comparison int
+
+const (
+	ORDERED Comparison = iota
+	UNORDERED
+	EQUAL
+)
+
+func parsePairs(in string) ([][]any, error) {
+	pairs := [][]any{}
+
+	blocks := aoc.Blocks(in)
+	if len(blocks) == 0 {
+		return nil, aoc.EmptyError()
+	}
+
+	for _, p := range blocks {
+		if len(p) != 2 {
+			return nil, p[0].Error("", fmt.Errorf("expected a pair of packets, got %d lines", len(p)))
+		}
+
+		left, err := parsePacket(p[0])
+		if err != nil {
+			return nil, err
+		}
+		right, err := parsePacket(p[1])
+		if err != nil {
+			return nil, err
+		}
+
+		pairs = append(pairs, []any{left, right})
+	}
+
+	return pairs, nil
+}
+
+// parsePacket decodes the packet on l, a JSON list whose elements are numbers
+// or lists.
+func parsePacket(l aoc.Line) (any, error) {
+	var packet any
+	if err := json.Unmarshal([]byte(l.Text), &packet); err != nil {
+		var serr *json.SyntaxError
+		if errors.As(err, &serr) && serr.Offset > 0 && int(serr.Offset) <= len(l.Text) {
+			i := int(serr.Offset) - 1
+			return nil, l.ErrorAt(i, l.Text[i:i+1], err)
+		}
+		return nil, l.Error(l.Text, err)
+	}
+
+	if _, ok := packet.([]any); !ok {
+		return nil, l.Error(l.Text, errors.New("packet is not a list"))
+	}
+	if err := checkPacket(packet); err != nil {
+		return nil, l.Error(l.Text, err)
+	}
+
+	return packet, nil
+}
+
+// checkPacket returns an error if v holds anything but numbers and lists.
+func checkPacket(v any) error {
+	switch v := v.(type) {
+	case float64:
+		return nil
+	case []any:
+		for _, e := range v {
+			if err := checkPacket(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unexpected %T in packet", v)
+	}
+}
+
+func bothLists(left, right any) bool {
+	_, lok := left.([]any)
+	_, rok := right.([]any)
+	return lok && rok
+}
+
+func bothDigits(left, right any) bool {
+	_, lok := left.(float64)
+	_, rok := right.(float64)
+	return lok && rok
+}
+
+// Compare reports whether the left and right packets are in the right order.
+func Compare(left, right any) Comparison {
+
+	switch {
+	case bothLists(left, right):
+		l, r := left.([]any), right.([]any)
+		for i, v := range l {
+			if i >= len(r) {
+				return UNORDERED
+			}
+			cmp := Compare(v, r[i])
+			if cmp != EQUAL {
+				return cmp
+			}
+		}
+
+		if len(l) < len(r) {
+			return ORDERED
+		} else if len(l) > len(r) {
+			return UNORDERED
+		}
+		return EQUAL
+	case bothDigits(left, right):
+		l, r := left.(float64), right.(float64)
+
+		if l < r {
+			return ORDERED
+		} else if l > r {
+			return UNORDERED
+		}
+		return EQUAL
+	default: // mixed types
+		if l, ok := left.([]any); ok { // right is digit
+			r := right.(float64)
+			return Compare(l, []any{r})
+		}
+
+		l := left.(float64)
+		r := right.([]any)
+		return Compare([]any{l}, r)
+	}
+
+}
+
+type solver struct {
+	pairs [][]any
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.pairs, err = parsePairs(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	var sum int
+
+	for i, p := range s.pairs {
+		if Compare(p[0], p[1]) == ORDERED {
+			sum += i + 1
+		}
+	}
+
+	return aoc.Int(sum), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	p2 := []any{[]any{float64(2)}}
+	p6 := []any{[]any{float64(6)}}
+
+	var packets []any
+	for _, p := range s.pairs {
+		for _, pp := range p {
+			packets = append(packets, pp)
+		}
+	}
+	packets = append(packets, p2)
+	packets = append(packets, p6)
+
+	sort.Slice(packets, func(i, j int) bool {
+		return Compare(packets[i], packets[j]) == ORDERED
+	})
+
+	key := 1
+	for i, p := range packets {
+		if reflect.DeepEqual(p, p2) || reflect.DeepEqual(p, p6) {
+			key *= (i + 1)
+
+		}
+
+	}
+
+	return aoc.Int(key), nil
+}
@@ -0,0 +1,63 @@
+package day13
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 13, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(13), Part2: aoctest.Int(140)},
+	})
+}
+
+func TestCompare(t *testing.T) {
+	want := []Comparison{ORDERED, ORDERED, UNORDERED, ORDERED, UNORDERED, ORDERED, UNORDERED, UNORDERED}
+
+	pairs, err := parsePairs(ExampleInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pairs) != len(want) {
+		t.Fatalf("parsed %d pairs, want %d", len(pairs), len(want))
+	}
+
+	for i, p := range pairs {
+		if got := Compare(p[0], p[1]); got != want[i] {
+			t.Errorf("pair %d: Compare = %d, want %d", i+1, got, want[i])
+		}
+	}
+}
+
+func TestCompareEqual(t *testing.T) {
+	tests := []struct {
+		name        string
+		left, right any
+	}{
+		{"digits", 1.0, 1.0},
+		{"lists", []any{1.0, []any{2.0}}, []any{1.0, []any{2.0}}},
+		{"mixed", []any{3.0}, 3.0},
+		{"empty", []any{}, []any{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(tt.left, tt.right); got != EQUAL {
+				t.Errorf("Compare(%v, %v) = %d, want EQUAL", tt.left, tt.right, got)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 13, []aoctest.BadInput{
+		{Name: "not a list", Input: "[1]\n\"a\"\n", Line: 2, Column: 1},
+		{Name: "bad element", Input: "[1]\n[{}]\n", Line: 2, Column: 1},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 13) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 13, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 13, 2) }
@@ -0,0 +1,283 @@
+package day14
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/grid"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:           14,
+		Input:         Input,
+		Example:       ExampleInput,
+		Params:        aoc.Params{"size": 500, "offset": 250},
+		ExampleParams: aoc.Params{"size": 35, "offset": 485},
+		New: func(p aoc.Params) aoc.Solver {
+			return &solver{size: p["size"], offset: p["offset"]}
+		},
+	})
+}
+
+// The contents of a cave cell.
+const (
+	air    = '.'
+	rock   = '#'
+	sand   = 'o'
+	source = '+'
+)
+
+func isBlocked(v rune) bool {
+	return v == rock || v == sand
+}
+
+// display draws the part of the cave from min to max, inclusive, with row
+// numbers.
+func display(w io.Writer, cave *grid.Grid[rune], min, max grid.Point) {
+	for y := min.Y; y <= max.Y && y < cave.Height(); y++ {
+		fmt.Fprintf(w, "%03d ", y)
+		for x := min.X; x <= max.X && x < cave.Width(); x++ {
+			if x >= 0 {
+				fmt.Fprintf(w, "%c", cave.At(grid.Point{X: x, Y: y}))
+			}
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// toInt parses the coordinate c, found at offset i of l, as x,y.
+func toInt(l aoc.Line, i int, c string) (int, int, error) {
+	xs, ys, ok := strings.Cut(c, ",")
+	if !ok {
+		return 0, 0, l.ErrorAt(i, c, errors.New("expected a coordinate such as 498,4"))
+	}
+
+	x, err := l.AtoiAt(i, xs)
+	if err != nil {
+		return 0, 0, err
+	}
+	y, err := l.AtoiAt(i+len(xs)+1, ys)
+	if err != nil {
+		return 0, 0, err
+	}
+	if x < 0 || y < 0 {
+		return 0, 0, l.ErrorAt(i, c, errors.New("negative coordinate"))
+	}
+
+	return x, y, nil
+}
+
+// pathToPoints returns every point on the rock path l, which must lie within
+// the size x size cave starting at x=left.
+func pathToPoints(l aoc.Line, left, size int) ([]grid.Point, error) {
+	const sep = " -> "
+	parts := strings.Split(l.Text, sep)
+	var coords []grid.Point
+	offset := 0
+	for _, p := range parts {
+		x, y, err := toInt(l, offset, p)
+		if err != nil {
+			return nil, err
+		}
+		if x < left || x >= left+size || y >= size {
+			return nil, l.ErrorAt(offset, p, fmt.Errorf("rock outside the %dx%d cave starting at x=%d; adjust -p offset or -p size", size, size, left))
+		}
+		coords = append(coords, grid.Point{X: x, Y: y})
+		offset += len(p) + len(sep)
+	}
+	if len(coords) == 1 { // a single point of rock
+		return coords, nil
+	}
+
+	var points []grid.Point
+	for i := 1; i < len(coords); i++ {
+		prev := coords[i-1]
+		cur := coords[i]
+
+		switch {
+		case prev.X == cur.X: // vertical path
+			x := prev.X
+			var start, end int
+			if prev.Y < cur.Y {
+				start, end = prev.Y, cur.Y
+			} else if prev.Y > cur.Y {
+				start, end = cur.Y, prev.Y
+			} else {
+				start, end = cur.Y, cur.Y
+			}
+
+			for y := start; y <= end; y++ {
+				points = append(points, grid.Point{X: x, Y: y})
+			}
+		case prev.Y == cur.Y: // horizontal path
+			y := prev.Y
+			var start, end int
+			if prev.X < cur.X {
+				start, end = prev.X, cur.X
+			} else if prev.X > cur.X {
+				start, end = cur.X, prev.X
+			} else {
+				start, end = cur.X, cur.X
+			}
+
+			for x := start; x <= end; x++ {
+				points = append(points, grid.Point{X: x, Y: y})
+			}
+		default:
+			return nil, l.Error(l.Text, fmt.Errorf("segment %d,%d -> %d,%d is not vertical or horizontal", prev.X, prev.Y, cur.X, cur.Y))
+		}
+	}
+
+	return points, nil
+}
+
+// parsePaths returns every rock point on the scanned paths, along with the y of
+// the lowest rock. The paths must lie within the size x size cave starting at
+// x=left.
+func parsePaths(in string, left, size int) ([]grid.Point, int, error) {
+	paths := aoc.Lines(in)
+	if len(paths) == 0 {
+		return nil, 0, aoc.EmptyError()
+	}
+
+	var rocks []grid.Point
+	maxY := 0
+	for _, path := range paths {
+		points, err := pathToPoints(path, left, size)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, p := range points {
+			if p.Y > maxY {
+				maxY = p.Y
+			}
+		}
+		rocks = append(rocks, points...)
+	}
+
+	return rocks, maxY, nil
+}
+
+// newCave returns a size x size cave shifted left by offset, with the floor
+// placed two below the lowest rock.
+func newCave(rocks []grid.Point, maxY, size, offset int) *grid.Grid[rune] {
+	cave := grid.New[rune](size, size)
+	cave.Each(func(p grid.Point, _ rune) {
+		cave.Set(p, air)
+	})
+
+	for _, r := range rocks {
+		cave.Set(grid.Point{X: r.X - offset, Y: r.Y}, rock)
+	}
+
+	for x := 0; x < size; x++ {
+		cave.Set(grid.Point{X: x, Y: maxY + 2}, rock)
+	}
+
+	return cave
+}
+
+type solver struct {
+	size, offset int // the cave is a size x size grid starting at x=offset
+
+	rocks []grid.Point
+	maxY  int
+
+	rec anim.Recorder
+}
+
+func (s *solver) Record(r anim.Recorder) {
+	s.rec = r
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.rocks, s.maxY, err = parsePaths(in, s.offset, s.size)
+	if err != nil {
+		return err
+	}
+
+	// the floor is two below the lowest rock, and must fit in the cave
+	if s.maxY+2 >= s.size {
+		return fmt.Errorf("floor at y=%d is outside the %dx%d cave; raise -p size", s.maxY+2, s.size, s.size)
+	}
+
+	return nil
+}
+
+// below are the moves a unit of sand tries, in order.
+var below = []grid.Point{grid.Down, {X: -1, Y: 1}, {X: 1, Y: 1}}
+
+// fall drops a unit of sand from p and returns the point it comes to rest at.
+// It is an error for the sand to fall off the side of the cave.
+func fall(cave *grid.Grid[rune], p grid.Point, offset int) (grid.Point, error) {
+	for _, d := range below {
+		n := p.Add(d)
+		v, ok := cave.Get(n)
+		if !ok {
+			return p, fmt.Errorf("sand fell out of the cave at %d,%d; adjust -p offset or -p size", n.X+offset, n.Y)
+		}
+		if !isBlocked(v) {
+			return fall(cave, n, offset)
+		}
+	}
+
+	cave.Set(p, sand) // at rest
+	return p, nil
+}
+
+// run pours sand into the cave and returns the number of units that come to
+// rest. When abyss is set, pouring stops once a unit falls below the lowest
+// rock, otherwise it stops once the source is blocked.
+func (s *solver) run(abyss bool) (int, error) {
+	offset := s.offset
+	cave := newCave(s.rocks, s.maxY, s.size, offset)
+
+	start := grid.Point{X: 500 - offset, Y: 0}
+	if !cave.Set(start, source) {
+		return 0, fmt.Errorf("the sand source at 500,0 is outside the cave; adjust -p offset or -p size")
+	}
+
+	count := 0
+	for !isBlocked(cave.At(start)) {
+		p, err := fall(cave, start, offset)
+		if err != nil {
+			return 0, err
+		}
+		if abyss && p.Y > s.maxY { // only the floor could have stopped it
+			break
+		}
+		count++
+
+		if s.rec != nil {
+			// sand can spread no further sideways than it falls
+			depth := s.maxY + 2
+			min, max := grid.Point{X: start.X - depth, Y: 0}, grid.Point{X: start.X + depth, Y: depth}
+			s.rec.Frame(anim.Capture(func(w io.Writer) { display(w, cave, min, max) }))
+		}
+	}
+
+	return count, nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	n, err := s.run(true)
+	return aoc.Int(n), err
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	n, err := s.run(false)
+	return aoc.Int(n), err
+}
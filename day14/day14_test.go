@@ -0,0 +1,26 @@
+package day14
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 14, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(24), Part2: aoctest.Int(93)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 14, []aoctest.BadInput{
+		{Name: "bad coordinate", Input: "498,4 -> 498,6\n503,4 -> 502,x\n", Line: 2, Column: 14},
+		{Name: "diagonal", Input: "498,4 -> 500,6\n", Line: 1, Column: 1},
+		{Name: "outside the cave", Input: "498,4 -> 498,6\n503,4 -> 9999,4\n", Line: 2, Column: 10},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 14) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 14, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 14, 2) }
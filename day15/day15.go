@@ -1,18 +1,34 @@
-package main
+package day15
 
 import (
+	"context"
 	_ "embed"
+	"errors"
 	"fmt"
 	"sort"
-	"strconv"
 	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
 )
 
 //go:embed example.txt
-var exampleInput string
+var ExampleInput string
 
 //go:embed input.txt
-var input string
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:           15,
+		Input:         Input,
+		Example:       ExampleInput,
+		Params:        aoc.Params{"y": 2000000, "max": limit},
+		ExampleParams: aoc.Params{"y": 10, "max": 20},
+		New: func(p aoc.Params) aoc.Solver {
+			return &solver{y: p["y"], max: p["max"]}
+		},
+	})
+}
 
 const limit = 4000000
 
@@ -45,43 +61,69 @@ func (s sensor) distance(b point) int {
 	return abs(s.x-b.x) + abs(s.y-b.y)
 }
 
-func parseSensors(in string) map[sensor]point {
+func parseSensors(in string) (map[sensor]point, error) {
 	sensors := map[sensor]point{} // sensor to closest beacon
-	lines := strings.Split(strings.TrimSpace(in), "\n")
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return nil, aoc.EmptyError()
+	}
 
-	extract := func(s string) int {
-		st := strings.Trim(s, ",:")
-		parts := strings.Split(st, "=")
-		if len(parts) != 2 {
-			panic("invalid input")
-		}
+	for _, l := range lines {
+		// extract returns the value of a field such as x=2, or y=18:
+		extract := func(f aoc.Field, name string) (int, error) {
+			value, ok := strings.CutPrefix(strings.TrimRight(f.Text, ",:"), name+"=")
+			if !ok {
+				return 0, l.ErrorAt(f.Offset, f.Text, fmt.Errorf("expected %s=N", name))
+			}
 
-		d, err := strconv.Atoi(parts[1])
-		if err != nil {
-			panic(fmt.Errorf("invalid input: %w", err))
+			return l.AtoiAt(f.Offset+len(name)+1, value)
 		}
 
-		return d
-	}
-
-	for _, l := range lines {
-		parts := strings.Fields(l)
+		parts := l.Fields()
 		if len(parts) != 10 {
-			panic("invalid input")
+			return nil, l.Error(l.Text, errors.New("expected \"Sensor at x=X, y=Y: closest beacon is at x=X, y=Y\""))
+		}
+
+		var coords [4]int
+		for i, f := range []aoc.Field{parts[2], parts[3], parts[8], parts[9]} {
+			var err error
+			if coords[i], err = extract(f, []string{"x", "y"}[i%2]); err != nil {
+				return nil, err
+			}
 		}
-		sx := extract(parts[2]) // x=2,
-		sy := extract(parts[3]) // y=18:
-		bx := extract(parts[8]) // x=-2,
-		by := extract(parts[9]) // y=15
 
-		sensors[sensor{sx, sy}] = point{bx, by}
+		sensors[sensor{coords[0], coords[1]}] = point{coords[2], coords[3]}
 	}
 
-	return sensors
+	return sensors, nil
 }
 
-func part1(in string, y int) int {
-	sensors := parseSensors(in)
+type solver struct {
+	y   int // row to count covered positions in for part 1
+	max int // largest x and y the distress beacon can be at for part 2
+
+	sensors map[sensor]point
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.sensors, err = parseSensors(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(s.sensors, s.y)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	freq, err := part2(s.sensors, 0, s.max)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(freq), nil
+}
+
+func part1(sensors map[sensor]point, y int) int {
 
 	positions := map[point]struct{}{}
 	for s, b := range sensors {
@@ -105,8 +147,9 @@ func part1(in string, y int) int {
 
 }
 
-func part2(in string, min, max int) int {
-	sensors := parseSensors(in)
+// part2 returns the tuning frequency of the one position from min to max in
+// both x and y that no sensor covers.
+func part2(sensors map[sensor]point, min, max int) (int, error) {
 
 	spans := map[int][]span{}
 	for s, b := range sensors {
@@ -122,7 +165,7 @@ func part2(in string, min, max int) int {
 	}
 
 	for y, xspans := range spans {
-		if y < 0 || y > max {
+		if y < min || y > max {
 			continue
 		}
 
@@ -138,24 +181,18 @@ func part2(in string, min, max int) int {
 				}
 			} else { // non-covered point, aka hidden beacon
 
-				return (cmax+1)*limit + y
+				return (cmax+1)*limit + y, nil
 			}
 		}
 
 	}
 
-	return -1
+	return 0, fmt.Errorf("no distress beacon in range [%d, %d]", min, max)
 }
 
-func main() {
-	fmt.Printf("Part 1 example: %d\n", part1(exampleInput, 10))
-	fmt.Printf("Part 1: %d\n", part1(input, 2000000))
-	fmt.Printf("Part 2 example: %d\n", part2(exampleInput, 0, 20))
-	fmt.Printf("Part 2: %d\n", part2(input, 0, limit))
-}
-
-func part2Slow(in string, min, max int) int {
-	sensors := parseSensors(in)
+// part2Slow finds the distress beacon by checking every position, which is
+// far too slow for the real input. It gives up when ctx is done.
+func part2Slow(ctx context.Context, sensors map[sensor]point, min, max int) (int, error) {
 	positions := map[point]struct{}{}
 
 	filtered := map[sensor]point{}
@@ -174,8 +211,12 @@ func part2Slow(in string, min, max int) int {
 		filtered[s] = b
 	}
 
+	ck := aoc.NewCheckpoint(ctx, 1<<10)
 	for y := min; y <= max; y++ {
 		for x := min; x <= max; x++ {
+			if ck.Done() {
+				return -1, fmt.Errorf("searched up to row %d: %w", y, ck.Err())
+			}
 			// check if point within distance of s
 			for s, b := range filtered {
 				p := point{x, y}
@@ -195,5 +236,5 @@ func part2Slow(in string, min, max int) int {
 		}
 	}
 
-	return xp*limit + yp
+	return xp*limit + yp, nil
 }
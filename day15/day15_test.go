@@ -0,0 +1,55 @@
+package day15
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 15, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(26), Part2: aoctest.Int(56000011)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 15, []aoctest.BadInput{
+		{Name: "bad y", Input: "Sensor at x=2, y=18: closest beacon is at x=-2, y=15\nSensor at x=9, y=1a: closest beacon is at x=10, y=16\n", Line: 2, Column: 18},
+	})
+}
+
+func TestNoBeacon(t *testing.T) {
+	// one sensor covering every position the beacon could be at
+	sensors, err := parseSensors("Sensor at x=10, y=10: closest beacon is at x=10, y=-20\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := part2(sensors, 0, 20); err == nil || err.Error() != "no distress beacon in range [0, 20]" {
+		t.Errorf("part2 = %d, %v, want no distress beacon", got, err)
+	}
+}
+
+func TestPart2Slow(t *testing.T) {
+	sensors, err := parseSensors(ExampleInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := part2Slow(context.Background(), sensors, 0, 20); err != nil || got != 56000011 {
+		t.Errorf("part2Slow = %d, %v, want 56000011", got, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := part2Slow(ctx, sensors, 0, limit); !errors.Is(err, context.Canceled) {
+		t.Errorf("part2Slow when canceled = %v, want context.Canceled", err)
+	}
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 15) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 15, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 15, 2) }
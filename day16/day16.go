@@ -0,0 +1,247 @@
+package day16
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/graph"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     16,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+var inputRE = regexp.MustCompile(`^Valve ([A-Z]{2}) has flow rate=(\d+); tunnels? leads? to valves? ([A-Z, ]+)$`)
+
+type node struct {
+	ID string
+	v  int
+	i  int
+}
+
+func (n node) String() string {
+	return fmt.Sprintf("%d:%s:%d", n.i, n.ID, n.v)
+}
+
+type edge struct {
+	from, to string
+	weight   int
+}
+
+// Graph is the network of valves and the tunnels between them.
+type Graph struct {
+	nodes map[string]node
+	edges map[string]map[edge]struct{}
+}
+
+func newGraph() *Graph {
+	return &Graph{
+		nodes: make(map[string]node),
+		edges: make(map[string]map[edge]struct{}),
+	}
+}
+
+func (g *Graph) addNode(n node) {
+	g.nodes[n.ID] = n
+}
+
+func (g *Graph) addEdge(uID, vID string, weight int) {
+	if _, ok := g.edges[uID]; !ok {
+		g.edges[uID] = make(map[edge]struct{})
+	}
+
+	g.edges[uID][edge{uID, vID, weight}] = struct{}{}
+
+	// undirected
+	if _, ok := g.edges[vID]; !ok {
+		g.edges[vID] = make(map[edge]struct{})
+	}
+	g.edges[vID][edge{vID, uID, weight}] = struct{}{}
+}
+
+// maxValves is the most valves whose open state fits in the int bitmask used
+// by visit.
+const maxValves = 63
+
+// ReadGraph parses the valve scan into a Graph. Every tunnel must lead to a
+// scanned valve, and the scan must include the starting valve AA.
+func ReadGraph(in string) (*Graph, error) {
+	g := newGraph()
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return nil, aoc.EmptyError()
+	}
+	if len(lines) > maxValves {
+		return nil, lines[maxValves].Error("", fmt.Errorf("more than %d valves", maxValves))
+	}
+
+	tunnels := map[string]aoc.Line{} // tunnel destinations, and the line leading there
+	for i, l := range lines {
+		res := inputRE.FindStringSubmatchIndex(l.Text)
+		if res == nil {
+			return nil, l.Error(l.Text, errors.New("expected \"Valve XX has flow rate=N; tunnels lead to valves YY, ZZ\""))
+		}
+
+		valve := l.Text[res[2]:res[3]]
+		if _, dup := g.nodes[valve]; dup {
+			return nil, l.ErrorAt(res[2], valve, errors.New("valve scanned twice"))
+		}
+		rate, err := l.AtoiAt(res[4], l.Text[res[4]:res[5]])
+		if err != nil {
+			return nil, err
+		}
+		adj := strings.Split(l.Text[res[6]:res[7]], ", ")
+		g.addNode(node{ID: valve, v: rate, i: i})
+		for _, n := range adj {
+			g.addEdge(valve, n, 1) // each edge is 1 weight, or 1 minute cost
+			tunnels[n] = l
+		}
+
+	}
+
+	for n, l := range tunnels {
+		if _, ok := g.nodes[n]; !ok {
+			return nil, l.Error(n, errors.New("tunnel leads to a valve that isn't scanned"))
+		}
+	}
+	if _, ok := g.nodes["AA"]; !ok {
+		return nil, lines[0].Error("", errors.New("no valve AA to start from"))
+	}
+
+	return g, nil
+}
+
+// AllShortest returns the shortest paths between each pair of valves.
+func (g *Graph) AllShortest() *graph.Distances[string] {
+	ids := make([]string, 0, len(g.nodes))
+	for id := range g.nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return graph.FloydWarshall(ids, func(id string) []graph.Edge[string] {
+		var out []graph.Edge[string]
+		for e := range g.edges[id] {
+			out = append(out, graph.Edge[string]{To: e.to, Cost: e.weight})
+		}
+		return out
+	})
+}
+
+// visit records in state the most pressure released for each set of opened
+// valves reachable from src in time, until ck is done.
+func visit(ck *aoc.Checkpoint, g *Graph, src string, opened, time, released int, distances *graph.Distances[string], state map[int]int) {
+	if time <= 0 || ck.Done() {
+		return
+	}
+
+	if r, ok := state[opened]; !ok || released > r {
+		state[opened] = released
+	}
+
+	for id, n := range g.nodes {
+		if n.v == 0 { // don't bother calculating if node has zero flow
+			continue
+		}
+		if opened&(1<<n.i) != 0 {
+			continue
+		}
+
+		d, ok := distances.Dist(src, id)
+		if !ok { // no tunnels lead there
+			continue
+		}
+		cost := (time - d - 1) // minus one to open
+		score := n.v * cost
+		visit(ck, g, id, opened|(1<<n.i), cost, released+score, distances, state)
+	}
+}
+
+type solver struct {
+	g         *Graph
+	distances *graph.Distances[string]
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	if s.g, err = ReadGraph(in); err != nil {
+		return err
+	}
+	s.distances = s.g.AllShortest()
+
+	return nil
+}
+
+// checkEvery is how many steps of a search go by between checks for
+// cancellation.
+const checkEvery = 1 << 10
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return s.Part1Context(context.Background())
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return s.Part2Context(context.Background())
+}
+
+func (s *solver) Part1Context(ctx context.Context) (aoc.Answer, error) {
+	ck := aoc.NewCheckpoint(ctx, checkEvery)
+	state := make(map[int]int)
+	visit(ck, s.g, "AA", 0, 30, 0, s.distances, state)
+	max := 0
+	for _, v := range state {
+		if v > max {
+			max = v
+		}
+	}
+	return aoc.Int(max), aoc.Partial(ck.Err())
+}
+
+func (s *solver) Part2Context(ctx context.Context) (aoc.Answer, error) {
+	ck := aoc.NewCheckpoint(ctx, checkEvery)
+	state := make(map[int]int)
+
+	visit(ck, s.g, "AA", 0, 26, 0, s.distances, state)
+
+	// at worst the elephant opens nothing
+	max := 0
+	for _, v := range state {
+		if v > max {
+			max = v
+		}
+	}
+	for p1, v1 := range state {
+		if ck.Done() {
+			break
+		}
+		for p2, v2 := range state {
+			if (p1 & p2) == 0 {
+				if v1+v2 > max {
+					max = v1 + v2
+				}
+			}
+		}
+
+	}
+
+	return aoc.Int(max), aoc.Partial(ck.Err())
+}
@@ -0,0 +1,107 @@
+package day16
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+	"github.com/nickshine/adventofcode2022/gen"
+	"github.com/nickshine/adventofcode2022/graph"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 16, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(1651), Part2: aoctest.Int(1707)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 16, []aoctest.BadInput{
+		{Name: "unknown valve", Input: "Valve AA has flow rate=0; tunnels lead to valves BB\n", Line: 1, Column: 50},
+		{Name: "bad line", Input: "Valve AA has flow rate=zero\n", Line: 1, Column: 1},
+	})
+}
+
+// TestRelease checks Part1 against release, the simpler search it replaced, on
+// generated networks.
+func TestRelease(t *testing.T) {
+	for seed := uint64(1); seed <= 20; seed++ {
+		in, err := gen.Generate(16, seed, 20)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := &solver{}
+		if err := s.Parse(in.Text); err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+
+		want := release(s.g.nodes, s.distances, "AA", 0, 0, 0, 30)
+		if got, err := s.Part1(); err != nil || got != aoc.Int(want) {
+			t.Errorf("seed %d: Part1 = %v, %v, want %d", seed, got, err, want)
+		}
+	}
+}
+
+// release is the first Part1: a plain search over each order of opening the
+// valves, checked against by TestRelease.
+func release(nodes map[string]node, distances *graph.Distances[string], node string, time, pressure, flow, limit int) int {
+	// assume no more valves will open at first
+	max := pressure + (limit-time)*flow
+
+	for id, n := range nodes {
+		if n.v == 0 { // don't bother calculating if node has zero flow
+			continue
+		}
+		d, ok := distances.Dist(node, id)
+		if !ok { // no tunnels lead there
+			continue
+		}
+		cost := d + 1 //shortest path from node to id node, + 1 to open
+		if time+cost >= limit {
+			continue
+		}
+		t := time + cost
+		p := pressure + cost*flow
+		f := flow + n.v
+		result := release(deleteNode(nodes, id), distances, id, t, p, f, limit)
+		if result > max {
+			max = result
+		}
+	}
+
+	return max
+}
+
+func deleteNode(nodes map[string]node, k string) map[string]node {
+
+	out := make(map[string]node, len(nodes))
+
+	for k, v := range nodes {
+		out[k] = v
+	}
+
+	delete(out, k)
+	return out
+}
+
+func TestCanceled(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(Input); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if _, err := s.Part2Context(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Part2Context = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 16) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 16, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 16, 2) }
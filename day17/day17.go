@@ -0,0 +1,456 @@
+package day17
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/cycle"
+	"github.com/nickshine/adventofcode2022/grid"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:           17,
+		Input:         Input,
+		Example:       ExampleInput,
+		Params:        aoc.Params{"rocks": 2022},
+		ExampleParams: aoc.Params{"rocks": 2022},
+		New: func(p aoc.Params) aoc.Solver {
+			return &solver{rocks: p["rocks"]}
+		},
+	})
+}
+
+const (
+	maxWidth = 7
+)
+
+var (
+	shapes = []shape{
+		{
+			{0, 0, 0, 0},
+			{0, 0, 0, 0},
+			{0, 0, 0, 0},
+			{1, 1, 1, 1},
+		},
+		{
+			{0, 0, 0, 0},
+			{0, 1, 0, 0},
+			{1, 1, 1, 0},
+			{0, 1, 0, 0},
+		},
+		{
+			{0, 0, 0, 0},
+			{0, 0, 1, 0},
+			{0, 0, 1, 0},
+			{1, 1, 1, 0},
+		},
+		{
+			{1, 0, 0, 0},
+			{1, 0, 0, 0},
+			{1, 0, 0, 0},
+			{1, 0, 0, 0},
+		},
+		{
+			{0, 0, 0, 0},
+			{0, 0, 0, 0},
+			{1, 1, 0, 0},
+			{1, 1, 0, 0},
+		},
+	}
+)
+
+type shape [4][4]int
+
+var trace = aoc.Tracer(17)
+
+type rock struct {
+	x, y          int // the coordinate of bottom left edge of a 4x4 grid of units
+	width, height int
+	s             shape // a 4x4 grid where 1's indicate rock and 0's indicate empty space
+	jetIndex      int
+	stopped       bool
+}
+
+func newRock(x, y int, s shape) *rock {
+	r := &rock{x: x, y: y, s: s}
+
+	// set width and height
+	maxx, maxy := 0, 4
+	// for each column
+	for y := 0; y < len(r.s); y++ {
+		hasY := false
+		for x := 0; x < len(r.s); x++ {
+			if r.s[y][x] == 0 {
+				continue
+			}
+
+			hasY = true
+			if x > maxx {
+				maxx = x
+			}
+		}
+		if !hasY {
+			maxy--
+		}
+
+	}
+
+	r.width = maxx + 1
+	r.height = maxy
+
+	return r
+
+}
+
+func (r *rock) String() string {
+	return fmt.Sprintf("{x:%d,y:%d,w:%d,h:%d}", r.x, r.y, r.width, r.height)
+}
+
+func (r *rock) overlapping(t *rock) bool {
+	tracing := trace.Enabled(context.Background(), aoc.LevelTrace)
+
+	if t.y+t.height <= r.y {
+		if tracing {
+			trace.Log(context.Background(), aoc.LevelTrace, "lower than current, skipping", "rock", t, "current", r)
+		}
+		return false
+	}
+
+	// ts left or right of current cannot block
+	if t.x+t.width <= r.x || t.x >= r.x+r.width {
+		if tracing {
+			trace.Log(context.Background(), aoc.LevelTrace, "left or right of current, skipping", "rock", t, "current", r)
+		}
+		return false
+	}
+
+	for y := 0; y < len(r.s); y++ {
+		for x := 0; x < len(r.s); x++ {
+			if r.s[y][x] == 0 { // empty space can overlap
+				continue
+			}
+			// is r.s[y][x] overlapping any of t.s?
+			for ty := 0; ty < len(t.s); ty++ {
+				for tx := 0; tx < len(t.s); tx++ {
+					if t.s[ty][tx] == 0 {
+						continue
+					}
+
+					if r.x+x != t.x+tx {
+						continue
+					}
+
+					if r.y+len(r.s)-1-y != t.y+len(t.s)-1-ty {
+						continue
+					}
+
+					if tracing {
+						trace.Log(context.Background(), aoc.LevelTrace, "overlaps", "current", r, "rock", t, "x", r.x+x, "y", r.y+len(r.s)-1-y)
+					}
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (r *rock) draw(w io.Writer) {
+	for _, v := range r.s {
+		for _, vv := range v {
+			if vv == 1 {
+				fmt.Fprintf(w, "#")
+			} else {
+				fmt.Fprintf(w, " ")
+			}
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func parseJets(in string) ([]int, error) {
+	lines := aoc.Lines(strings.TrimSpace(in))
+	switch {
+	case len(lines) == 0:
+		return nil, aoc.EmptyError()
+	case len(lines) > 1:
+		return nil, lines[1].Error(lines[1].Text, errors.New("expected the jet pattern on a single line"))
+	}
+
+	s := lines[0].Text
+	jets := make([]int, len(s))
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '<':
+			jets[i] = -1
+		case '>':
+			jets[i] = 1
+		default:
+			return nil, lines[0].ErrorAt(i, s[i:i+1], errors.New("expected a jet < or >"))
+		}
+	}
+
+	return jets, nil
+}
+
+func push(r *rock, rocks []*rock, push int) bool {
+	x := r.x + push
+	// cannot push past left or right wall
+	if x < 0 || x+r.width > maxWidth {
+		return false
+	}
+
+	curx := r.x
+	r.x += push
+	for _, rock := range rocks {
+		if r.overlapping(rock) {
+
+			r.x = curx // put r.x back
+			return false
+		}
+	}
+
+	if trace.Enabled(context.Background(), aoc.LevelTrace) {
+		trace.Log(context.Background(), aoc.LevelTrace, "pushed", "by", push, "rock", r)
+	}
+	return true
+}
+
+func fall(r *rock, rocks []*rock) bool {
+	// cannot fall below floor
+	if r.y == 0 {
+		if trace.Enabled(context.Background(), aoc.LevelTrace) {
+			trace.Log(context.Background(), aoc.LevelTrace, "on the floor, stopping", "rock", r)
+		}
+		r.stopped = true
+		return false
+	}
+
+	curY := r.y
+	r.y--
+	for _, rock := range rocks {
+		if r.overlapping(rock) {
+
+			r.y = curY // put r.y back
+			if trace.Enabled(context.Background(), aoc.LevelTrace) {
+				trace.Log(context.Background(), aoc.LevelTrace, "on another rock, stopping", "rock", r, "on", rock)
+			}
+			r.stopped = true
+			return false
+		}
+	}
+
+	if trace.Enabled(context.Background(), aoc.LevelTrace) {
+		trace.Log(context.Background(), aoc.LevelTrace, "fell one", "rock", r)
+	}
+	return true
+}
+
+// chamber is the tall, narrow chamber the rocks fall into.
+type chamber struct {
+	jets      []int
+	rocks     []*rock
+	jetIdx    int // the last jet used
+	shapeType int // the shape of the next rock
+	maxHeight int
+
+	tops [maxWidth]int // the height of the highest rock in each column
+
+	rec anim.Recorder // sent the top of the chamber after each rock, if set
+}
+
+// frameRows is how much of the top of the chamber is recorded.
+const frameRows = 40
+
+func newChamber(jets []int, rec anim.Recorder) *chamber {
+	return &chamber{jets: jets, jetIdx: -1, rec: rec}
+}
+
+// drop drops the next rock until it comes to rest.
+func (c *chamber) drop() {
+	r := newRock(2, c.maxHeight+3, shapes[c.shapeType])
+	trace.Debug("new rock", "rock", r)
+
+	// run current rock sequence until stopped
+	for {
+		c.jetIdx = (c.jetIdx + 1) % len(c.jets)
+		push(r, c.rocks, c.jets[c.jetIdx])
+		fall(r, c.rocks)
+		if r.stopped {
+			break
+		}
+	}
+
+	r.jetIndex = c.jetIdx
+
+	if r.y+r.height > c.maxHeight {
+		c.maxHeight = r.y + r.height
+		trace.Debug("new max height", "height", c.maxHeight)
+	}
+
+	for y := 0; y < len(r.s); y++ {
+		for x := 0; x < len(r.s); x++ {
+			if top := r.y + len(r.s) - y; r.s[y][x] == 1 && top > c.tops[r.x+x] {
+				c.tops[r.x+x] = top
+			}
+		}
+	}
+
+	c.rocks = append(c.rocks, r)
+	c.shapeType = (c.shapeType + 1) % len(shapes)
+
+	if c.rec != nil {
+		c.rec.Frame(anim.Capture(func(w io.Writer) { c.draw(w, frameRows) }))
+	}
+}
+
+// draw draws the top count rows of the chamber, between its walls.
+func (c *chamber) draw(w io.Writer, count int) {
+	ystart := c.maxHeight - count
+	if ystart < 0 {
+		ystart = 0
+	}
+
+	rows := nRows(c.rocks, ystart, count)
+	for y := count - 1; y >= 0; y-- {
+		fmt.Fprint(w, "|")
+		for x := 0; x < maxWidth; x++ {
+			if rows.At(grid.Point{X: x, Y: y}) == 1 {
+				fmt.Fprint(w, "#")
+			} else {
+				fmt.Fprint(w, ".")
+			}
+		}
+		fmt.Fprintln(w, "|")
+	}
+}
+
+// skylineDepth is how far below the top of the tower the skyline is told
+// apart. Rocks come to rest near the top, so a column open deeper than this
+// is as good as open all the way down; without a bound, a column that never
+// fills gets deeper with every rock, and the chamber never repeats itself.
+const skylineDepth = 64
+
+// chamberKey identifies the state of the chamber for finding a cycle: the next
+// shape and jet, and how far below the top of the tower each column is, up to
+// skylineDepth.
+type chamberKey struct {
+	shape, jet int
+	skyline    [maxWidth]int
+}
+
+func (c *chamber) key() chamberKey {
+	k := chamberKey{shape: c.shapeType, jet: c.jetIdx}
+	for x, top := range c.tops {
+		k.skyline[x] = min(c.maxHeight-top, skylineDepth)
+	}
+
+	return k
+}
+
+func run(jets []int, count int, rec anim.Recorder) (int, []*rock) {
+	c := newChamber(jets, rec)
+	for i := 0; i < count; i++ {
+		c.drop()
+	}
+
+	return c.maxHeight, c.rocks
+}
+
+// nRows returns count rows of the chamber starting at ystart, with 1 for rock.
+// Row 0 of the grid is the row at ystart, so the grid is upside down.
+func nRows(rocks []*rock, ystart, count int) *grid.Grid[int] {
+	rows := grid.New[int](maxWidth, count)
+
+	for _, r := range rocks {
+		if r.y >= ystart+count {
+			if trace.Enabled(context.Background(), aoc.LevelTrace) {
+				trace.Log(context.Background(), aoc.LevelTrace, "rock above the rows", "rock", r, "ystart", ystart)
+			}
+			continue
+		}
+
+		if r.y+r.height <= ystart {
+			if trace.Enabled(context.Background(), aoc.LevelTrace) {
+				trace.Log(context.Background(), aoc.LevelTrace, "rock below the rows", "rock", r, "ystart", ystart)
+			}
+			continue
+		}
+
+		for y := 0; y < len(r.s); y++ {
+			for x := 0; x < len(r.s); x++ {
+				if r.s[y][x] == 0 {
+					continue
+				}
+
+				yy := r.y + len(r.s) - 1 - y
+				if !rows.Set(grid.Point{X: r.x + x, Y: yy - ystart}, 1) {
+					if trace.Enabled(context.Background(), aoc.LevelTrace) {
+						trace.Log(context.Background(), aoc.LevelTrace, "rock unit outside the rows", "y", yy, "ystart", ystart)
+					}
+					continue
+				}
+			}
+		}
+	}
+
+	return rows
+
+}
+
+type solver struct {
+	rocks int // number of rocks to drop for part 1
+
+	jets []int
+
+	rec anim.Recorder
+}
+
+func (s *solver) Record(r anim.Recorder) {
+	s.rec = r
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.jets, err = parseJets(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	maxHeight, _ := run(s.jets, s.rocks, s.rec)
+	return aoc.Int(maxHeight), nil
+}
+
+// Part2 drops far too many rocks to simulate, so it simulates until the
+// chamber repeats itself and extrapolates the height from there.
+func (s *solver) Part2() (aoc.Answer, error) {
+	const rocks = 1000000000000
+	const limit = 10000
+
+	c := newChamber(s.jets, s.rec)
+	cyc, err := cycle.Find(func(n int) (chamberKey, int) {
+		if n > 0 {
+			c.drop()
+		}
+		return c.key(), c.maxHeight
+	}, limit)
+	if err != nil {
+		return aoc.Answer{}, fmt.Errorf("rocks never settle into a cycle: %w", err)
+	}
+	trace.Debug("cycle", "prefix", cyc.Prefix, "period", cyc.Period, "delta", cyc.Delta)
+
+	return aoc.Int(cyc.At(rocks)), nil
+}
@@ -0,0 +1,26 @@
+package day17
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 17, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(3068), Part2: aoctest.Int(1514285714288)},
+		// every rock is blown to the left, so the right columns never fill
+		{Name: "open columns", Input: "<\n", Part1: aoctest.Int(4448), Part2: aoctest.Int(2200000000000)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 17, []aoctest.BadInput{
+		{Name: "bad jet", Input: "<<>x>\n", Line: 1, Column: 4},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 17) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 17, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 17, 2) }
@@ -0,0 +1,233 @@
+package day18
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     18,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+const (
+	Empty = iota
+	Filled
+	Visited
+)
+
+type grid [][][]int
+
+// maxCoord bounds each coordinate, since the droplet is scanned into a dense
+// grid.
+const maxCoord = 100
+
+func parse(in string) (positions [][3]int, maxX int, maxY int, maxZ int, err error) {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return nil, 0, 0, 0, aoc.EmptyError()
+	}
+
+	seen := map[[3]int]bool{}
+	for _, l := range lines {
+		xyz := strings.Split(l.Text, ",")
+		if len(xyz) != 3 {
+			return nil, 0, 0, 0, l.Error(l.Text, errors.New("expected a cube such as 2,2,2"))
+		}
+
+		var pos [3]int
+		offset := 0
+		for i, c := range xyz {
+			n, err := l.AtoiAt(offset, c)
+			if err != nil {
+				return nil, 0, 0, 0, err
+			}
+			if n < 0 || n > maxCoord {
+				return nil, 0, 0, 0, l.ErrorAt(offset, c, fmt.Errorf("coordinate outside 0-%d", maxCoord))
+			}
+			pos[i] = n
+			offset += len(c) + 1
+		}
+		if seen[pos] {
+			return nil, 0, 0, 0, l.Error(l.Text, errors.New("cube scanned twice"))
+		}
+		seen[pos] = true
+
+		x, y, z := pos[0], pos[1], pos[2]
+		if x > maxX {
+			maxX = x
+		}
+		if y > maxY {
+			maxY = y
+		}
+		if z > maxZ {
+			maxZ = z
+		}
+
+		positions = append(positions, pos)
+	}
+
+	return positions, maxX + 1, maxY + 1, maxZ + 1, nil
+}
+
+// newGrid creates a 3d slice of ints - [z][y][x]
+func newGrid(maxX, maxY, maxZ int) grid {
+	grid := make([][][]int, maxZ)
+	for i := 0; i < maxZ; i++ {
+		grid[i] = make([][]int, maxY)
+		for j := 0; j < maxY; j++ {
+			grid[i][j] = make([]int, maxX)
+		}
+	}
+
+	return grid
+}
+
+func (g grid) get(x, y, z int) (int, bool) {
+
+	if z < 0 || y < 0 || x < 0 {
+		return 0, false
+	}
+
+	if z >= len(g) || y >= len(g[z]) || x >= len(g[z][y]) {
+		return 0, false
+	}
+
+	return g[z][y][x], true
+}
+
+func (g grid) isFilled(x, y, z int) bool {
+	c, ok := g.get(x, y, z)
+	return ok && c == Filled
+}
+
+// insert inserts the given cube's xyz and returns the surface area gained or lost.
+// It is assumed the grid is large enough to hold the given xyz.
+func (g grid) insert(x, y, z int) (int, error) {
+	surfaceAreaDelta := 6 // 6 sides to a cube
+
+	if g[z][y][x] != Empty {
+		return 0, fmt.Errorf("cube %d,%d,%d inserted twice", x, y, z)
+	}
+
+	// check all 6 sides of cube for adjacent:
+	// z-axis
+	if g.isFilled(x, y, z+1) {
+		surfaceAreaDelta -= 2 // current cube loses 1, and the adjacent loses 1
+	}
+	if g.isFilled(x, y, z-1) {
+		surfaceAreaDelta -= 2
+	}
+
+	// y-axis
+	if g.isFilled(x, y+1, z) {
+		surfaceAreaDelta -= 2
+	}
+	if g.isFilled(x, y-1, z) {
+		surfaceAreaDelta -= 2
+	}
+
+	// x-axis
+	if g.isFilled(x+1, y, z) {
+		surfaceAreaDelta -= 2
+	}
+	if g.isFilled(x-1, y, z) {
+		surfaceAreaDelta -= 2
+	}
+
+	g[z][y][x] = Filled
+
+	return surfaceAreaDelta, nil
+}
+
+// neighbors are the offsets of the 6 cubes sharing a side.
+var neighbors = [6][3]int{{0, 0, 1}, {0, 0, -1}, {0, 1, 0}, {0, -1, 0}, {1, 0, 0}, {-1, 0, 0}}
+
+func findSurface(g grid, x, y, z int) (int, error) {
+	c, ok := g.get(x, y, z)
+	if !ok {
+		return 0, nil
+	}
+
+	switch c {
+	case Visited:
+		return 0, nil
+	case Filled:
+		return 1, nil
+	case Empty:
+		g[z][y][x] = Visited
+	default:
+		return 0, fmt.Errorf("cube %d,%d,%d is in unknown state %d", x, y, z, c)
+	}
+
+	// dfs
+	surface := 0
+	for _, n := range neighbors {
+		s, err := findSurface(g, x+n[0], y+n[1], z+n[2])
+		if err != nil {
+			return 0, err
+		}
+		surface += s
+	}
+
+	return surface, nil
+}
+
+type solver struct {
+	positions        [][3]int
+	maxX, maxY, maxZ int
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.positions, s.maxX, s.maxY, s.maxZ, err = parse(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	g := newGrid(s.maxX, s.maxY, s.maxZ)
+	surfaceArea := 0
+
+	for _, p := range s.positions {
+		delta, err := g.insert(p[0], p[1], p[2])
+		if err != nil {
+			return aoc.Answer{}, err
+		}
+		surfaceArea += delta
+	}
+
+	return aoc.Int(surfaceArea), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	g := newGrid(s.maxX+2, s.maxY+2, s.maxZ+2) // add to each dimension to allow DFS scan of exterior, empty space
+
+	for _, p := range s.positions {
+		// shift positions off 1 to make empty 0,0,0 space for DFS
+		if _, err := g.insert(p[0]+1, p[1]+1, p[2]+1); err != nil {
+			return aoc.Answer{}, err
+		}
+	}
+
+	surface, err := findSurface(g, 0, 0, 0)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(surface), nil
+}
@@ -0,0 +1,36 @@
+package day18
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 18, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(64), Part2: aoctest.Int(58)},
+	})
+}
+
+func TestInsertTwice(t *testing.T) {
+	g := newGrid(2, 2, 2)
+	if _, err := g.insert(1, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.insert(1, 0, 1); err == nil {
+		t.Error("inserting a cube twice: want an error")
+	}
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 18, []aoctest.BadInput{
+		{Name: "bad coordinate", Input: "1,1,1\n2,x,1\n", Line: 2, Column: 3},
+		{Name: "duplicate", Input: "1,1,1\n1,1,1\n", Line: 2, Column: 1},
+		{Name: "negative", Input: "1,-1,1\n", Line: 1, Column: 3},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 18) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 18, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 18, 2) }
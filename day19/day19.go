@@ -0,0 +1,298 @@
+package day19
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"log/slog"
+	"regexp"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     19,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+var inputRE = regexp.MustCompile(`^Blueprint (\d+): Each ore robot costs (\d+) ore. Each clay robot costs (\d+) ore. Each obsidian robot costs (\d+) ore and (\d+) clay. Each geode robot costs (\d+) ore and (\d+) obsidian.$`)
+
+type blueprint struct {
+	id                int
+	oreOreCost        int
+	clayOreCost       int
+	obsidianOreCost   int
+	obsidianClayCost  int
+	geodeOreCost      int
+	geodeObsidianCost int
+	maxOre            int
+	maxClay           int
+	maxObsidian       int
+}
+
+func parseBlueprints(in string) ([]blueprint, error) {
+	var blueprints []blueprint
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return nil, aoc.EmptyError()
+	}
+
+	for _, l := range lines {
+		res := inputRE.FindStringSubmatchIndex(l.Text)
+		if res == nil {
+			return nil, l.Error(l.Text, errors.New("expected a blueprint such as \"Blueprint 1: Each ore robot costs 4 ore. ...\""))
+		}
+
+		var n [7]int
+		for i := range n {
+			start, end := res[2*i+2], res[2*i+3]
+			var err error
+			if n[i], err = l.AtoiAt(start, l.Text[start:end]); err != nil {
+				return nil, err
+			}
+		}
+		id, oreOreCost, clayOreCost, obsidianOreCost, obsidianClayCost, geodeOreCost, geodeObsidianCost := n[0], n[1], n[2], n[3], n[4], n[5], n[6]
+
+		maxOre := oreOreCost
+		maxClay := obsidianClayCost
+		maxObsidian := geodeObsidianCost
+
+		if clayOreCost > maxOre {
+			maxOre = clayOreCost
+		}
+		if obsidianOreCost > maxOre {
+			maxOre = obsidianOreCost
+		}
+		if geodeOreCost > maxOre {
+			maxOre = geodeOreCost
+		}
+
+		blueprints = append(blueprints, blueprint{
+			id,
+			oreOreCost,
+			clayOreCost,
+			obsidianOreCost,
+			obsidianClayCost,
+			geodeOreCost,
+			geodeObsidianCost,
+			maxOre,
+			maxClay,
+			maxObsidian,
+		})
+	}
+
+	return blueprints, nil
+}
+
+type state struct {
+	oreRobots      int
+	clayRobots     int
+	obsidianRobots int
+	geodeRobots    int
+	ore            int
+	clay           int
+	obsidian       int
+	geode          int
+	best           map[int]int // best geode for a time
+}
+
+// LogValue logs the robots and resources of s, leaving out best.
+func (s state) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Group("robots", "ore", s.oreRobots, "clay", s.clayRobots, "obsidian", s.obsidianRobots, "geode", s.geodeRobots),
+		slog.Group("have", "ore", s.ore, "clay", s.clay, "obsidian", s.obsidian, "geode", s.geode),
+	)
+}
+
+var trace = aoc.Tracer(19)
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// search looks for the most geodes a blueprint can open by the end of a
+// minute.
+type search struct {
+	ck      *aoc.Checkpoint
+	b       blueprint
+	maxTime int
+
+	// tracing is whether to log each state, worked out once per search as
+	// asking the logger on every step is too slow
+	tracing bool
+}
+
+func newSearch(ck *aoc.Checkpoint, b blueprint, maxTime int) *search {
+	return &search{ck: ck, b: b, maxTime: maxTime, tracing: trace.Enabled(context.Background(), aoc.LevelTrace)}
+}
+
+// run returns the most geodes that can be opened from state s at the start
+// of minute time, or the most found before the search's checkpoint is done.
+func (se *search) run(time int, s state) int {
+	b, maxTime, tracing := &se.b, se.maxTime, se.tracing
+	if time > maxTime {
+		return s.geode
+	}
+
+	// the last few minutes, where almost all the steps are, are searched
+	// too quickly to be worth checking for cancellation in
+	timeLeft := maxTime - time
+	if timeLeft >= checkDepth && se.ck.Done() {
+		return s.geode
+	}
+
+	ore, clay, obsidian := s.ore, s.clay, s.obsidian
+	if tracing {
+		trace.Log(context.Background(), aoc.LevelTrace, "minute", "time", time, "state", s)
+	}
+
+	// each robot collects
+	s.ore += s.oreRobots
+	s.clay += s.clayRobots
+	s.obsidian += s.obsidianRobots
+	s.geode += s.geodeRobots
+
+	// don't continue searching if there is already a time with more geodes
+	if s.geode < s.best[time] {
+		return s.geode
+	}
+
+	s.best[time] = max(s.geode, s.best[time])
+
+	// at most three options, kept off the heap as allocating them was most
+	// of the search's time
+	var options [3]state
+	n := 0
+	// geode - if geode robot can be built, force build it over other options
+	if ore >= b.geodeOreCost && obsidian >= b.geodeObsidianCost {
+		opt := s
+		opt.ore -= b.geodeOreCost
+		opt.obsidian -= b.geodeObsidianCost
+		opt.geodeRobots++
+		if tracing {
+			trace.Log(context.Background(), aoc.LevelTrace, "build geode robot", "time", time, "option", opt)
+		}
+		return se.run(time+1, opt)
+	}
+
+	// ore
+	if ore >= b.oreOreCost && s.oreRobots < b.maxOre && s.oreRobots*timeLeft+ore < timeLeft*b.maxOre {
+		opt := s
+		opt.ore -= b.oreOreCost
+		opt.oreRobots++
+		if tracing {
+			trace.Log(context.Background(), aoc.LevelTrace, "build ore robot", "time", time, "option", opt)
+		}
+		options[n] = opt
+		n++
+	}
+	// clay
+	if ore >= b.clayOreCost && s.clayRobots < b.maxClay && s.clayRobots*timeLeft+clay < timeLeft*b.maxClay {
+		opt := s
+		opt.ore -= b.clayOreCost
+		opt.clayRobots++
+		if tracing {
+			trace.Log(context.Background(), aoc.LevelTrace, "build clay robot", "time", time, "option", opt)
+		}
+		options[n] = opt
+		n++
+	}
+	// obsidian
+	if ore >= b.obsidianOreCost && clay >= b.obsidianClayCost && s.obsidianRobots < b.maxObsidian && s.obsidianRobots*timeLeft+obsidian < timeLeft*b.maxObsidian {
+		opt := s
+		opt.ore -= b.obsidianOreCost
+		opt.clay -= b.obsidianClayCost
+		opt.obsidianRobots++
+		if tracing {
+			trace.Log(context.Background(), aoc.LevelTrace, "build obsidian robot", "time", time, "option", opt)
+		}
+		options[n] = opt
+		n++
+	}
+
+	maxGeode := s.geode
+	for _, opt := range options[:n] {
+		numGeode := se.run(time+1, opt)
+		if numGeode > maxGeode {
+			maxGeode = numGeode
+		}
+	}
+
+	return max(maxGeode, se.run(time+1, s))
+
+}
+
+type solver struct {
+	blueprints []blueprint
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.blueprints, err = parseBlueprints(in)
+	return err
+}
+
+// A search checks for cancellation every checkEvery steps with at least
+// checkDepth minutes left.
+const (
+	checkEvery = 1 << 4
+	checkDepth = 8
+)
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return s.Part1Context(context.Background())
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return s.Part2Context(context.Background())
+}
+
+func (s *solver) Part1Context(ctx context.Context) (aoc.Answer, error) {
+	ck := aoc.NewCheckpoint(ctx, checkEvery)
+	total := 0
+	for _, b := range s.blueprints {
+		max := newSearch(ck, b, 24).run(1, state{oreRobots: 1, best: map[int]int{}})
+		trace.Debug("blueprint", "id", b.id, "geodes", max)
+		total += b.id * max
+		if ck.Err() != nil {
+			break
+		}
+	}
+
+	return aoc.Int(total), aoc.Partial(ck.Err())
+}
+
+func (s *solver) Part2Context(ctx context.Context) (aoc.Answer, error) {
+	ck := aoc.NewCheckpoint(ctx, checkEvery)
+	blueprints := s.blueprints
+	if len(blueprints) > 3 { // only the first three blueprints survived
+		blueprints = blueprints[:3]
+	}
+
+	total := 1
+	for _, b := range blueprints {
+		max := newSearch(ck, b, 32).run(1, state{oreRobots: 1, best: map[int]int{}})
+		trace.Debug("blueprint", "id", b.id, "geodes", max)
+		total *= max
+		if ck.Err() != nil {
+			break
+		}
+	}
+
+	return aoc.Int(total), aoc.Partial(ck.Err())
+}
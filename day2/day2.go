@@ -0,0 +1,87 @@
+package day2
+
+import (
+	_ "embed"
+	"errors"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     2,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+// round is one line of the strategy guide. Both columns are 0, 1 or 2; the
+// opponent's is rock, paper or scissors.
+type round struct {
+	opponent, column int
+}
+
+type solver struct {
+	rounds []round
+}
+
+func (s *solver) Parse(in string) error {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return aoc.EmptyError()
+	}
+
+	for _, l := range lines {
+		t := l.Text
+		if len(t) != 3 || t[0] < 'A' || t[0] > 'C' || t[1] != ' ' || t[2] < 'X' || t[2] > 'Z' {
+			return l.Error(t, errors.New("expected a round such as \"A Y\""))
+		}
+
+		s.rounds = append(s.rounds, round{int(t[0] - 'A'), int(t[2] - 'X')})
+	}
+
+	return nil
+}
+
+// score returns the shape score plus the outcome score of playing shape
+// against opponent.
+func score(opponent, shape int) int {
+	switch (shape - opponent + 3) % 3 {
+	case 0: // draw
+		return shape + 1 + 3
+	case 1: // win
+		return shape + 1 + 6
+	default: // lose
+		return shape + 1
+	}
+}
+
+// Part1 reads the second column as the shape to play.
+func (s *solver) Part1() (aoc.Answer, error) {
+	total := 0
+	for _, r := range s.rounds {
+		total += score(r.opponent, r.column)
+	}
+
+	return aoc.Int(total), nil
+}
+
+// Part2 reads the second column as the outcome: lose, draw or win.
+func (s *solver) Part2() (aoc.Answer, error) {
+	total := 0
+	for _, r := range s.rounds {
+		shape := (r.opponent + r.column + 2) % 3 // lose is one shape behind, win one ahead
+		total += score(r.opponent, shape)
+	}
+
+	return aoc.Int(total), nil
+}
@@ -0,0 +1,24 @@
+package day2
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 2, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(15), Part2: aoctest.Int(12)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 2, []aoctest.BadInput{
+		{Name: "bad shape", Input: "A Y\nB Q\n", Line: 2, Column: 1},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 2) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 2, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 2, 2) }
@@ -1,17 +1,30 @@
-package main
+package day20
 
 import (
 	_ "embed"
+	"errors"
 	"fmt"
-	"strconv"
 	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
 )
 
 //go:embed example.txt
-var exampleInput string
+var ExampleInput string
 
 //go:embed input.txt
-var input string
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     20,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
 
 const decryptionKey = 811589153
 
@@ -75,51 +88,56 @@ func (l *list) removeNode(n *node) {
 	}
 }
 
-func (l *list) display() {
-	p := l.head
-	for {
+func parseInput(in string) ([]int, error) {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return nil, aoc.EmptyError()
+	}
 
-		if p.next == l.head {
-			fmt.Printf("-> %dt ", p.value)
-			break
+	values := make([]int, 0, len(lines))
+	zeros := 0
+	for _, l := range lines {
+		v, err := l.Atoi(strings.TrimSpace(l.Text))
+		if err != nil {
+			return nil, err
 		}
-
-		if p == l.head {
-			fmt.Printf("-> %dh ", p.value)
-		} else {
-			fmt.Printf("-> %d ", p.value)
-
+		if v == 0 {
+			zeros++
 		}
+		values = append(values, v)
+	}
 
-		p = p.next
+	// mixing moves each number among the others, and the grove coordinates are
+	// counted from the one 0
+	if len(values) < 2 {
+		return nil, lines[0].Error("", errors.New("expected at least two numbers"))
+	}
+	if zeros != 1 {
+		return nil, lines[0].Error("", fmt.Errorf("found %d zeros, want exactly one", zeros))
 	}
 
-	fmt.Println()
+	return values, nil
 }
 
-func parseInput(in string, part2 bool) ([]*node, *list) {
-	lines := strings.Split(strings.TrimSpace(in), "\n")
+// newList returns a circular list of values each multiplied by key, along with
+// its nodes in their original order.
+func newList(values []int, key int) ([]*node, *list) {
 	list := &list{}
 	var nodes []*node
 
-	for _, l := range lines {
-		v, err := strconv.Atoi(l)
-		if err != nil {
-			panic("invalid input")
-		}
-
-		if part2 {
-			v *= decryptionKey
-		}
-		node := list.insert(v)
+	for _, v := range values {
+		node := list.insert(v * key)
 		nodes = append(nodes, node)
 	}
 
 	return nodes, list
 }
 
+// errNoZero is returned by a mix with no 0 to count the grove coordinates from.
+var errNoZero = errors.New("no 0 to count the grove coordinates from")
+
 // mix returns the zero node
-func mix(nodes []*node, l *list) *node {
+func mix(nodes []*node, l *list) (*node, error) {
 	var zero *node
 	for _, n := range nodes {
 		p := n
@@ -134,20 +152,21 @@ func mix(nodes []*node, l *list) *node {
 			for i := 0; i >= n.value; i-- {
 				p = p.prev
 			}
-		case n.value == 0:
+		default:
 			zero = n
 			continue
-		default:
-			panic("invalid input")
 		}
 
 		l.insertNode(p, n)
 	}
 
-	return zero
+	if zero == nil {
+		return nil, errNoZero
+	}
+	return zero, nil
 }
 
-func mix2(nodes []*node, l *list) *node {
+func mix2(nodes []*node, l *list) (*node, error) {
 	var zero *node
 	length := len(nodes)
 	for i := 0; i < 10; i++ {
@@ -174,13 +193,29 @@ func mix2(nodes []*node, l *list) *node {
 		}
 	}
 
-	return zero
+	if zero == nil {
+		return nil, errNoZero
+	}
+	return zero, nil
+}
+
+type solver struct {
+	values []int
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.values, err = parseInput(in)
+	return err
 }
 
-func part1(in string) int {
-	nodes, list := parseInput(in, false)
+func (s *solver) Part1() (aoc.Answer, error) {
+	nodes, list := newList(s.values, 1)
 
-	zero := mix(nodes, list)
+	zero, err := mix(nodes, list)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
 
 	sum := 0
 	p := zero
@@ -191,13 +226,16 @@ func part1(in string) int {
 		sum += p.value
 	}
 
-	return sum
+	return aoc.Int(sum), nil
 }
 
-func part2(in string) int {
-	nodes, list := parseInput(in, true)
+func (s *solver) Part2() (aoc.Answer, error) {
+	nodes, list := newList(s.values, decryptionKey)
 
-	zero := mix2(nodes, list)
+	zero, err := mix2(nodes, list)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
 
 	sum := 0
 	p := zero
@@ -208,12 +246,5 @@ func part2(in string) int {
 		sum += p.value
 	}
 
-	return sum
-}
-
-func main() {
-	fmt.Printf("Part 1 example: %d\n", part1(exampleInput))
-	fmt.Printf("Part 1: %d\n", part1(input))
-	fmt.Printf("Part 2 example: %d\n", part2(exampleInput))
-	fmt.Printf("Part 2: %d\n", part2(input))
+	return aoc.Int(sum), nil
 }
@@ -0,0 +1,109 @@
+package day20
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 20, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(3), Part2: aoctest.Int(1623178306)},
+	})
+}
+
+// values walks l from head to tail, checking the prev links agree with the
+// next links on the way.
+func values(t *testing.T, l *list) []int {
+	t.Helper()
+
+	var vs []int
+	p := l.head
+	for {
+		if p.next.prev != p {
+			t.Fatalf("node %d: next.prev does not link back", p.value)
+		}
+		vs = append(vs, p.value)
+		if p == l.tail {
+			break
+		}
+		p = p.next
+	}
+
+	if l.tail.next != l.head || l.head.prev != l.tail {
+		t.Fatal("list is not circular")
+	}
+
+	return vs
+}
+
+func TestRemoveNode(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove int
+		want   []int
+	}{
+		{"head", 0, []int{2, 3, 4, 5}},
+		{"middle", 2, []int{1, 2, 4, 5}},
+		{"tail", 4, []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, l := newList([]int{1, 2, 3, 4, 5}, 1)
+			l.removeNode(nodes[tt.remove])
+			if got := values(t, l); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("after removeNode got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInsertNode(t *testing.T) {
+	tests := []struct {
+		name        string
+		move, after int
+		want        []int
+	}{
+		{"forward", 0, 2, []int{2, 3, 1, 4, 5}},
+		{"backward", 3, 0, []int{1, 4, 2, 3, 5}},
+		{"after tail", 1, 4, []int{1, 3, 4, 5, 2}},
+		{"tail to middle", 4, 1, []int{1, 2, 5, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, l := newList([]int{1, 2, 3, 4, 5}, 1)
+			l.removeNode(nodes[tt.move])
+			l.insertNode(nodes[tt.after], nodes[tt.move])
+			if got := values(t, l); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("after insertNode got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMixNoZero(t *testing.T) {
+	nodes, l := newList([]int{1, 2, 3}, 1)
+	if _, err := mix(nodes, l); !errors.Is(err, errNoZero) {
+		t.Errorf("mix err = %v, want errNoZero", err)
+	}
+	nodes, l = newList([]int{1, 2, 3}, 1)
+	if _, err := mix2(nodes, l); !errors.Is(err, errNoZero) {
+		t.Errorf("mix2 err = %v, want errNoZero", err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 20, []aoctest.BadInput{
+		{Name: "not a number", Input: "1\n2\nx\n0\n", Line: 3, Column: 1},
+		{Name: "no zero", Input: "1\n2\n", Line: 1, Column: 0},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 20) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 20, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 20, 2) }
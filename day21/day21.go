@@ -0,0 +1,248 @@
+package day21
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/graph"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:           21,
+		Input:         Input,
+		Example:       ExampleInput,
+		Params:        aoc.Params{"start": 3099532690000, "end": 3099532700000},
+		ExampleParams: aoc.Params{"start": 300, "end": 302},
+		New: func(p aoc.Params) aoc.Solver {
+			return &solver{start: p["start"], end: p["end"]}
+		},
+	})
+}
+
+type job func(a, b int) int
+type monkey struct {
+	name    string
+	monkeys [2]string // the monkeys whose numbers this one waits on
+	job     job
+	divides bool     // whether the job is a division, which fails on zero
+	line    aoc.Line // where the monkey is defined
+}
+
+func (m *monkey) String() string {
+	return fmt.Sprintf("{name:%s, monkeys:%v}", m.name, m.monkeys)
+}
+
+func parseInput(in string) (map[string]int, []*monkey, error) {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return nil, nil, aoc.EmptyError()
+	}
+
+	simpleMonkeys := map[string]int{}
+	var complexMonkeys []*monkey
+	defined := map[string]bool{}
+	waits := map[string]aoc.Line{} // monkeys waited on, and the line waiting
+
+	for _, l := range lines {
+		parts := l.Fields()
+		if len(parts) != 2 && len(parts) != 4 || !strings.HasSuffix(parts[0].Text, ":") {
+			return nil, nil, l.Error(l.Text, errors.New("expected \"name: N\" or \"name: a op b\""))
+		}
+
+		name := strings.TrimRight(parts[0].Text, ":")
+		if defined[name] {
+			return nil, nil, l.ErrorAt(parts[0].Offset, name, errors.New("monkey defined twice"))
+		}
+		defined[name] = true
+
+		if len(parts) == 2 {
+			num, err := l.AtoiAt(parts[1].Offset, parts[1].Text)
+			if err != nil {
+				return nil, nil, err
+			}
+			simpleMonkeys[name] = num
+		} else {
+			var job job
+			switch parts[2].Text {
+			case "+":
+				job = func(a, b int) int { return a + b }
+			case "-":
+				job = func(a, b int) int { return a - b }
+			case "*":
+				job = func(a, b int) int { return a * b }
+			case "/":
+				job = func(a, b int) int { return a / b }
+			default:
+				return nil, nil, l.ErrorAt(parts[2].Offset, parts[2].Text, errors.New("expected an operator +, -, * or /"))
+			}
+
+			waits[parts[1].Text], waits[parts[3].Text] = l, l
+			complexMonkeys = append(complexMonkeys, &monkey{name: name, monkeys: [2]string{parts[1].Text, parts[3].Text}, job: job, divides: parts[2].Text == "/", line: l})
+		}
+	}
+
+	for name, l := range waits {
+		if !defined[name] {
+			return nil, nil, l.Error(name, errors.New("waits on a monkey that isn't defined"))
+		}
+	}
+	if !defined["root"] {
+		return nil, nil, lines[0].Error("", errors.New("no root monkey"))
+	}
+
+	order, err := jobOrder(complexMonkeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return simpleMonkeys, order, nil
+}
+
+// jobOrder sorts the monkeys with jobs so that each comes after the monkeys it
+// waits on.
+func jobOrder(monkeys []*monkey) ([]*monkey, error) {
+	byName := make(map[string]*monkey, len(monkeys))
+	for _, m := range monkeys {
+		byName[m.name] = m
+	}
+
+	names := make([]string, len(monkeys))
+	for i, m := range monkeys {
+		names[i] = m.name
+	}
+
+	sorted, err := graph.TopoSort(names, func(name string) []string {
+		if m, ok := byName[name]; ok {
+			return m.monkeys[:]
+		}
+		return nil // a monkey that yells a number waits on nobody
+	})
+	if err != nil {
+		var cycle *graph.CycleError[string]
+		if errors.As(err, &cycle) {
+			m := byName[cycle.Cycle[0]]
+			return nil, m.line.Error(m.name, err)
+		}
+		return nil, err
+	}
+
+	order := make([]*monkey, 0, len(monkeys))
+	for _, name := range sorted {
+		if m, ok := byName[name]; ok {
+			order = append(order, m)
+		}
+	}
+
+	return order, nil
+}
+
+// yell works out the number of every monkey, given the monkeys that yell a
+// number and the others in job order. It fails if a monkey divides by zero.
+func yell(simple map[string]int, order []*monkey) (map[string]int, error) {
+	numbers := make(map[string]int, len(simple)+len(order))
+	for k, v := range simple {
+		numbers[k] = v
+	}
+
+	for _, m := range order {
+		a, b := numbers[m.monkeys[0]], numbers[m.monkeys[1]]
+		if m.divides && b == 0 {
+			return nil, fmt.Errorf("monkey %s divides by %s, which yells 0", m.name, m.monkeys[1])
+		}
+		numbers[m.name] = m.job(a, b)
+	}
+
+	return numbers, nil
+}
+
+var trace = aoc.Tracer(21)
+
+type solver struct {
+	start, end int // range of humn values to search for part 2
+
+	simple map[string]int
+	order  []*monkey // the monkeys with jobs, each after those it waits on
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.simple, s.order, err = parseInput(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	numbers, err := yell(s.simple, s.order)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(numbers["root"]), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return s.Part2Context(context.Background())
+}
+
+func (s *solver) Part1Context(context.Context) (aoc.Answer, error) {
+	return s.Part1()
+}
+
+func (s *solver) Part2Context(ctx context.Context) (aoc.Answer, error) {
+	order := make([]*monkey, len(s.order))
+	for i, m := range s.order {
+		if m.name == "root" {
+			root := *m
+			root.divides = false
+			root.job = func(a, b int) int {
+				if trace.Enabled(context.Background(), aoc.LevelTrace) {
+					trace.Log(context.Background(), aoc.LevelTrace, "root operands should match", "a", a, "b", b)
+				}
+				return a - b
+			}
+			m = &root
+		}
+		order[i] = m
+	}
+
+	simple := make(map[string]int, len(s.simple))
+	for k, v := range s.simple {
+		simple[k] = v
+	}
+
+	// a humn value that has a monkey divide by zero can't be the one, but
+	// the first is reported in case none is
+	var divErr error
+
+	ck := aoc.NewCheckpoint(ctx, 1<<10)
+	for i := s.start; i < s.end; i++ {
+		if ck.Done() {
+			return aoc.Answer{}, fmt.Errorf("no humn value in [%d, %d) balances root: %w", s.start, i, ck.Err())
+		}
+		simple["humn"] = i
+
+		numbers, err := yell(simple, order)
+		if err != nil {
+			if divErr == nil {
+				divErr = fmt.Errorf("with humn %d, %w", i, err)
+			}
+			continue
+		}
+		if numbers["root"] == 0 { // operands match
+			return aoc.Int(i), nil
+		}
+	}
+	if divErr != nil {
+		return aoc.Answer{}, fmt.Errorf("no humn value in [%d, %d) balances root (%w)", s.start, s.end, divErr)
+	}
+	return aoc.Answer{}, fmt.Errorf("no humn value in [%d, %d) balances root", s.start, s.end)
+}
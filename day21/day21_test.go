@@ -0,0 +1,50 @@
+package day21
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 21, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(152), Part2: aoctest.Int(301)},
+	})
+}
+
+func TestDivideByZero(t *testing.T) {
+	puzzle, _ := aoc.Lookup(21)
+
+	// root divides by zero, whatever humn yells
+	s, err := puzzle.Load(strings.NewReader("root: a / b\na: 4\nb: humn - c\nc: 2\nhumn: 2\n"), aoc.Params{"start": 2, "end": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Part1(); err == nil || !strings.Contains(err.Error(), "divides by b") {
+		t.Errorf("Part1 = %v, want a division by zero error", err)
+	}
+
+	// only humn 0 divides by zero, and humn 1 balances root
+	s, err = puzzle.Load(strings.NewReader("root: a + b\na: c / humn\nb: 5\nc: 5\nhumn: 0\n"), aoc.Params{"start": 0, "end": 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err := s.Part2(); err != nil || a != aoc.Int(1) {
+		t.Errorf("Part2 = %v, %v, want 1", a, err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 21, []aoctest.BadInput{
+		{Name: "unknown monkey", Input: "root: aaaa + bbbb\naaaa: 1\n", Line: 1, Column: 14},
+		{Name: "cycle", Input: "root: a + b\na: b + c\nb: a * c\nc: 1\n", Line: 2, Column: 1},
+		{Name: "bad operator", Input: "root: a % b\na: 1\nb: 2\n", Line: 1, Column: 9},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 21) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 21, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 21, 2) }
@@ -1,18 +1,35 @@
-package main
+package day22
 
 import (
 	_ "embed"
+	"errors"
 	"fmt"
-	"strconv"
+	"io"
 	"strings"
-	"unicode"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/grid"
 )
 
 //go:embed example.txt
-var exampleInput string
+var ExampleInput string
 
 //go:embed input.txt
-var input string
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:           22,
+		Input:         Input,
+		Example:       ExampleInput,
+		Params:        aoc.Params{"face": 50},
+		ExampleParams: aoc.Params{"face": 4},
+		New: func(p aoc.Params) aoc.Solver {
+			return &solver{face: p["face"]}
+		},
+	})
+}
 
 type facing int
 
@@ -35,19 +52,24 @@ type step struct {
 	distance int
 }
 
-type grid [][]rune
+// board is the map of open tiles and walls. Off the map is a space. Moving
+// off an edge wraps around to the other side.
+type board struct {
+	*grid.Grid[rune]
+}
 
 type translateFunc func(x, y int, f facing) (int, int, facing)
 
-type region struct {
+// Region is one face of the cube, along with how to step off each of its edges.
+type Region struct {
 	xmin, xmax int
 	ymin, ymax int
 	translate  translateFunc
 }
 
-// getRegion returns the region that x,y is in from the provided array of regions.
+// getRegion returns the Region that x,y is in from the provided array of regions.
 // xmin, and ymin are included in the range, while xmax and ymax are excluded.
-func getRegion(regions []*region, x, y int) *region {
+func getRegion(regions []*Region, x, y int) *Region {
 
 	for _, r := range regions {
 		if x >= r.xmin && x < r.xmax && y >= r.ymin && y < r.ymax {
@@ -62,129 +84,115 @@ func turn(current facing, turn int) facing {
 	return facing((int(current) + turn) % 4)
 }
 
-func parseInput(in string) (grid, []step) {
-	parts := strings.Split(in, "\n\n")
-	rows := strings.Split(parts[0], "\n")
-	path := strings.TrimSpace(parts[1])
-
-	grid := make([][]rune, len(rows))
-
-	maxX := 0
-	for _, row := range rows {
-		if len(row) > maxX {
-			maxX = len(row)
-		}
+func parseInput(in string) (board, []step, error) {
+	blocks := aoc.Blocks(in)
+	switch {
+	case len(blocks) == 0:
+		return board{}, nil, aoc.EmptyError()
+	case len(blocks) != 2 || len(blocks[1]) != 1:
+		l := blocks[len(blocks)-1][0]
+		return board{}, nil, l.Error("", errors.New("expected the map, a blank line, then the path on one line"))
 	}
+	rows, path := blocks[0], blocks[1][0]
 
-	for i, row := range rows {
-		grid[i] = make([]rune, maxX)
-		if len(row) < maxX {
-			// prefill rows that are shorter than max
-			for j := 0; j < maxX; j++ {
-				grid[i][j] = ' '
-			}
-		}
-		for j, c := range row {
-			grid[i][j] = c
+	tiles, err := grid.ParseRagged(rows, func(r rune) (rune, error) {
+		if r != ' ' && r != '.' && r != '#' {
+			return 0, errors.New("expected an open tile ., a wall # or a space")
 		}
+		return r, nil
+	}, ' ')
+	if err != nil {
+		return board{}, nil, err
+	}
+	tiles.SetWrap(grid.WrapBoth)
+	if top := strings.TrimLeft(rows[0].Text, " "); top[0] != '.' {
+		return board{}, nil, rows[0].Error("", errors.New("the leftmost tile of the top row must be open"))
 	}
 
 	var steps []step
 	curTurn := turnR
-	for i, j := 0, 0; j <= len(path); {
-		if j == len(path) || unicode.IsLetter(rune(path[j])) {
-			distance, err := strconv.Atoi(path[i:j])
+	p := path.Text
+	for i, j := 0, 0; j <= len(p); {
+		if j == len(p) || p[j] == 'L' || p[j] == 'R' {
+			distance, err := path.AtoiAt(i, p[i:j])
 			if err != nil {
-				panic(err)
+				return board{}, nil, err
 			}
 
 			steps = append(steps, step{turn: curTurn, distance: distance})
 		}
 
-		if j == len(path) {
+		if j == len(p) {
 			break
-		} else if unicode.IsDigit(rune(path[j])) {
+		}
+
+		switch c := p[j]; {
+		case c >= '0' && c <= '9':
 			j++
-		} else if unicode.IsLetter(rune(path[j])) {
-			switch path[j] {
-			case 'L':
+		case c == 'L' || c == 'R':
+			curTurn = turnR
+			if c == 'L' {
 				curTurn = turnL
-			case 'R':
-				curTurn = turnR
 			}
 
 			j++
 			i = j
+		default:
+			return board{}, nil, path.ErrorAt(j, p[j:j+1], errors.New("expected a distance or a turn L or R"))
 		}
-
 	}
 
-	return grid, steps
+	return board{tiles}, steps, nil
 
 }
 
-func display(g grid) {
-	for _, row := range g {
-		for _, col := range row {
-			fmt.Printf("%c", col)
-		}
-		fmt.Println()
-	}
+func (b board) display(w io.Writer) {
+	b.Render(w, func(_ grid.Point, v rune) rune {
+		return v
+	})
 }
 
-func (g grid) findStart() (int, int) {
-
-	row := g[0]
-	for i, c := range row {
-		if c == ' ' {
-			continue
-		} else {
-			return 0, i
-		}
-	}
-
-	return 0, 0
+// start returns the leftmost open tile of the top row.
+func (b board) start() grid.Point {
+	p, _ := b.Find(func(v rune) bool {
+		return v == '.'
+	})
+	return p
 }
 
-// dxy returns the delta x and y to move in the proper direction.
-func (f facing) dxy() (int, int) {
-	var dy, dx int
+// dir returns the direction f faces.
+func (f facing) dir() grid.Point {
 	switch f {
 	case RIGHT:
-		dy, dx = 0, 1
+		return grid.Right
 	case DOWN:
-		dy, dx = 1, 0
+		return grid.Down
 	case LEFT:
-		dy, dx = 0, -1
-	case UP:
-		dy, dx = -1, 0
+		return grid.Left
+	default:
+		return grid.Up
 	}
-
-	return dx, dy
 }
 
-func (g grid) move(x, y, dx, dy int) (int, int, bool) {
-
-	nextY := (y + dy) % len(g)
-	if nextY < 0 {
-		nextY = len(g) + nextY
-	}
-	nextX := (x + dx) % len(g[0])
-	if nextX < 0 {
-		nextX = len(g[0]) + nextX
-	}
-	switch g[nextY][nextX] {
+// move steps from p in direction d, skipping over the spaces off the map, and
+// reports whether it could move before hitting a wall.
+func (b board) move(p, d grid.Point) (grid.Point, bool) {
+	next := b.Wrapped(p.Add(d))
+	switch b.At(next) {
 	case '.':
-		return nextX, nextY, true
+		return next, true
 	case '#':
-		return x, y, false
+		return p, false
 	default:
-		return g.move(nextX, nextY, dx, dy)
+		return b.move(next, d)
 	}
 }
 
-func (g grid) cubeMove(x, y int, f facing, r *region) (int, int, facing, bool) {
-	dx, dy := f.dxy()
+// cubeMove steps from x,y on face r facing f, folding over the cube's edges,
+// and reports whether it could move before hitting a wall.
+func (b board) cubeMove(x, y int, f facing, r *Region) (int, int, facing, bool, error) {
+	d := f.dir()
+	dx, dy := d.X, d.Y
 
 	// get current location reg
 	var nextX, nextY int
@@ -197,74 +205,142 @@ func (g grid) cubeMove(x, y int, f facing, r *region) (int, int, facing, bool) {
 		nextX, nextY, nextF = x+dx, y+dy, f
 	}
 
-	switch g[nextY][nextX] {
+	switch b.At(grid.Point{X: nextX, Y: nextY}) {
 	case '.':
-		return nextX, nextY, nextF, true
+		return nextX, nextY, nextF, true, nil
 	case '#':
-		return x, y, f, false
+		return x, y, f, false, nil
 	default:
-		panic("unexpected condition")
+		return x, y, f, false, fmt.Errorf("folding from %d,%d leads off the map to %d,%d", x, y, nextX, nextY)
+	}
+}
+
+type solver struct {
+	face int // edge length of a cube face, which picks the folding for part 2
+
+	board board
+	steps []step
+
+	rec anim.Recorder
+}
+
+func (s *solver) Record(r anim.Recorder) {
+	s.rec = r
+}
+
+// trail is the path walked so far, drawn over the board the way the puzzle
+// does, for recording.
+type trail struct {
+	board board
+	rec   anim.Recorder
+}
+
+// newTrail returns a trail to record to, or nil if nothing is recording.
+func (s *solver) newTrail() *trail {
+	if s.rec == nil {
+		return nil
+	}
+
+	return &trail{board{s.board.Clone()}, s.rec}
+}
+
+// mark marks p as walked through facing f.
+func (t *trail) mark(p grid.Point, f facing) {
+	if t != nil {
+		t.board.Set(p, rune(">v<^"[f]))
 	}
 }
 
-func part1(in string) int {
-	grid, steps := parseInput(in)
-	y, x := grid.findStart()
+func (t *trail) frame() {
+	if t != nil {
+		t.rec.Frame(anim.Capture(t.board.display))
+	}
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.board, s.steps, err = parseInput(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	b, steps := s.board, s.steps
+	p := b.start()
 	f := UP
+	t := s.newTrail()
 
 	for _, step := range steps {
 		f = turn(f, step.turn)
-		dx, dy := f.dxy()
+		t.mark(p, f)
 		for i := 0; i < step.distance; i++ {
-			nextX, nextY, ok := grid.move(x, y, dx, dy)
+			next, ok := b.move(p, f.dir())
 			if !ok {
 				break
 			}
-			x, y = nextX, nextY
+			p = next
+			t.mark(p, f)
 		}
+		t.frame()
 
 	}
 
-	return 1000*(y+1) + 4*(x+1) + int(f)
+	return aoc.Int(1000*(p.Y+1) + 4*(p.X+1) + int(f)), nil
 }
 
-func part2(in string, regions []*region) int {
-	grid, steps := parseInput(in)
-	y, x := grid.findStart()
+// Part2 folds the map into a cube. The folds were worked out by hand, so only
+// the example and puzzle input layouts are supported.
+func (s *solver) Part2() (aoc.Answer, error) {
+	var regions []*Region
+	switch s.face {
+	case 4:
+		regions = ExampleRegions()
+	case 50:
+		regions = InputRegions()
+	default:
+		return aoc.Answer{}, fmt.Errorf("no cube folding for face size %d", s.face)
+	}
+
+	b, steps := s.board, s.steps
+	start := b.start()
+	x, y := start.X, start.Y
 	f := UP // start up so first turn will end in RIGHT facing
+	t := s.newTrail()
 
 	for _, step := range steps {
 		f = turn(f, step.turn)
+		t.mark(grid.Point{X: x, Y: y}, f)
 
 		for i := 0; i < step.distance; i++ {
 			r := getRegion(regions, x, y)
-			nextX, nextY, nextF, ok := grid.cubeMove(x, y, f, r)
+			if r == nil {
+				return aoc.Answer{}, fmt.Errorf("%d,%d is on no face of the cube folding for face size %d", x, y, s.face)
+			}
+			nextX, nextY, nextF, ok, err := b.cubeMove(x, y, f, r)
+			if err != nil {
+				return aoc.Answer{}, err
+			}
 			if !ok {
 				break
 			}
 			x, y, f = nextX, nextY, nextF
+			t.mark(grid.Point{X: x, Y: y}, f)
 		}
+		t.frame()
 
 	}
 
-	return 1000*(y+1) + 4*(x+1) + int(f)
-
-}
+	return aoc.Int(1000*(y+1) + 4*(x+1) + int(f)), nil
 
-func main() {
-	fmt.Printf("Part 1 example: %d\n", part1(exampleInput))
-	fmt.Printf("Part 1: %d\n", part1(input))
-	fmt.Printf("Part 2 example: %d\n", part2(exampleInput, exampleRegions()))
-	fmt.Printf("Part 2: %d\n", part2(input, inputRegions()))
 }
 
-func exampleRegions() []*region {
-	r1 := &region{xmin: 8, xmax: 12, ymin: 0, ymax: 4}
-	r2 := &region{xmin: 0, xmax: 4, ymin: 4, ymax: 8}
-	r3 := &region{xmin: 4, xmax: 8, ymin: 4, ymax: 8}
-	r4 := &region{xmin: 8, xmax: 12, ymin: 4, ymax: 8}
-	r5 := &region{xmin: 8, xmax: 12, ymin: 8, ymax: 12}
-	r6 := &region{xmin: 12, xmax: 16, ymin: 8, ymax: 12}
+// ExampleRegions returns the cube faces of the example map.
+func ExampleRegions() []*Region {
+	r1 := &Region{xmin: 8, xmax: 12, ymin: 0, ymax: 4}
+	r2 := &Region{xmin: 0, xmax: 4, ymin: 4, ymax: 8}
+	r3 := &Region{xmin: 4, xmax: 8, ymin: 4, ymax: 8}
+	r4 := &Region{xmin: 8, xmax: 12, ymin: 4, ymax: 8}
+	r5 := &Region{xmin: 8, xmax: 12, ymin: 8, ymax: 12}
+	r6 := &Region{xmin: 12, xmax: 16, ymin: 8, ymax: 12}
 	r1.translate = func(x, y int, f facing) (int, int, facing) {
 		var nextX, nextY int
 		var nextF facing
@@ -411,17 +487,18 @@ func exampleRegions() []*region {
 		return nextX, nextY, nextF
 	}
 
-	return []*region{r1, r2, r3, r4, r5, r6}
+	return []*Region{r1, r2, r3, r4, r5, r6}
 
 }
 
-func inputRegions() []*region {
-	r1 := &region{xmin: 50, xmax: 100, ymin: 0, ymax: 50}
-	r2 := &region{xmin: 100, xmax: 150, ymin: 0, ymax: 50}
-	r3 := &region{xmin: 50, xmax: 100, ymin: 50, ymax: 100}
-	r4 := &region{xmin: 0, xmax: 50, ymin: 100, ymax: 150}
-	r5 := &region{xmin: 50, xmax: 100, ymin: 100, ymax: 150}
-	r6 := &region{xmin: 0, xmax: 50, ymin: 150, ymax: 200}
+// InputRegions returns the cube faces of the puzzle input map.
+func InputRegions() []*Region {
+	r1 := &Region{xmin: 50, xmax: 100, ymin: 0, ymax: 50}
+	r2 := &Region{xmin: 100, xmax: 150, ymin: 0, ymax: 50}
+	r3 := &Region{xmin: 50, xmax: 100, ymin: 50, ymax: 100}
+	r4 := &Region{xmin: 0, xmax: 50, ymin: 100, ymax: 150}
+	r5 := &Region{xmin: 50, xmax: 100, ymin: 100, ymax: 150}
+	r6 := &Region{xmin: 0, xmax: 50, ymin: 150, ymax: 200}
 	r1.translate = func(x, y int, f facing) (int, int, facing) {
 		var nextX, nextY int
 		var nextF facing
@@ -568,5 +645,5 @@ func inputRegions() []*region {
 		return nextX, nextY, nextF
 	}
 
-	return []*region{r1, r2, r3, r4, r5, r6}
+	return []*Region{r1, r2, r3, r4, r5, r6}
 }
@@ -0,0 +1,37 @@
+package day22
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 22, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(6032), Part2: aoctest.Int(5031)},
+	})
+}
+
+func TestUnfoldable(t *testing.T) {
+	// a map not laid out like the example, though its faces are as big
+	s := &solver{face: 4}
+	if err := s.Parse("....\n....\n\n1\n"); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.Part2(); err == nil || !strings.Contains(err.Error(), "on no face") {
+		t.Errorf("Part2 = %v, %v, want an error for a position on no face", got, err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 22, []aoctest.BadInput{
+		{Name: "bad turn", Input: "...#\n.#..\n\n10R5X\n", Line: 4, Column: 5},
+		{Name: "wall at start", Input: "#..\n...\n\n1\n", Line: 1, Column: 0},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 22) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 22, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 22, 2) }
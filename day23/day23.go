@@ -0,0 +1,312 @@
+package day23
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/grid"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     23,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+type direction int
+
+const (
+	NORTH direction = iota
+	SOUTH
+	WEST
+	EAST
+)
+
+// looks are the three points an elf checks before proposing to move in each
+// direction. The middle one is where it would move to.
+var looks = [4][3]grid.Point{
+	NORTH: {{X: -1, Y: -1}, grid.Up, {X: 1, Y: -1}},
+	SOUTH: {{X: -1, Y: 1}, grid.Down, {X: 1, Y: 1}},
+	WEST:  {{X: -1, Y: -1}, grid.Left, {X: -1, Y: 1}},
+	EAST:  {{X: 1, Y: -1}, grid.Right, {X: 1, Y: 1}},
+}
+
+type elf struct {
+	firstDirection direction
+}
+
+// grove is where the elves are. The elves spread out as far as they like, but
+// looking around every elf every round is much faster in a dense grid than in
+// a map, so the grove is a dense grid with a margin of ground around the
+// elves, grown whenever an elf reaches its edge.
+type grove struct {
+	*grid.Grid[*elf]
+	elves int
+}
+
+// margin is the ground added around the elves each time the grove grows.
+const margin = 16
+
+// newGrove returns a grove with an elf at every # of the scanned rows.
+func newGrove(rows []string) *grove {
+	scanned := grid.NewSparse[*elf](0, 0)
+	for y, row := range rows {
+		for x, c := range row {
+			if c == '#' {
+				scanned.Set(grid.Point{X: x, Y: y}, &elf{NORTH})
+			}
+		}
+	}
+
+	g := &grove{elves: scanned.Len()}
+	g.grow(scanned)
+
+	return g
+}
+
+// grow copies the elves of from into a new grid with margin ground around
+// them.
+func (g *grove) grow(from *grid.Grid[*elf]) {
+	min, max := elfBounds(from)
+	g.Grid = grid.New[*elf](max.X-min.X+1+2*margin, max.Y-min.Y+1+2*margin)
+	from.Range(func(p grid.Point, e *elf) {
+		if e != nil {
+			g.Set(grid.Point{X: p.X - min.X + margin, Y: p.Y - min.Y + margin}, e)
+		}
+	})
+}
+
+// onEdge reports whether p is on the edge of the grove, where an elf could
+// move off it.
+func (g *grove) onEdge(p grid.Point) bool {
+	return p.X == 0 || p.Y == 0 || p.X == g.Width()-1 || p.Y == g.Height()-1
+}
+
+// elfBounds returns the top left and bottom right of the smallest rectangle
+// that contains all elves of g.
+func elfBounds(g *grid.Grid[*elf]) (min, max grid.Point) {
+	first := true
+	g.Range(func(p grid.Point, e *elf) {
+		switch {
+		case e == nil:
+		case first:
+			min, max, first = p, p, false
+		default:
+			min.X, min.Y = minInt(min.X, p.X), minInt(min.Y, p.Y)
+			max.X, max.Y = maxInt(max.X, p.X), maxInt(max.Y, p.Y)
+		}
+	})
+
+	return min, max
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// countGround returns the empty ground tiles in the smallest rectangle that
+// contains all elves.
+func (g *grove) countGround() int {
+	min, max := elfBounds(g.Grid)
+	return (max.X+1-min.X)*(max.Y+1-min.Y) - g.elves
+}
+
+// display draws the smallest rectangle that contains all elves.
+func (g *grove) display(w io.Writer) {
+	min, max := elfBounds(g.Grid)
+	row := make([]byte, 0, max.X-min.X+2)
+	for y := min.Y; y <= max.Y; y++ {
+		row = row[:0]
+		for x := min.X; x <= max.X; x++ {
+			if g.At(grid.Point{X: x, Y: y}) != nil {
+				row = append(row, '#')
+			} else {
+				row = append(row, '.')
+			}
+		}
+		row = append(row, '\n')
+		w.Write(row)
+	}
+}
+
+func (g *grove) allClear(p grid.Point) bool {
+	for _, d := range grid.Neighbors8 {
+		if g.At(p.Add(d)) != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (g *grove) nextPosition(p grid.Point, e *elf) (grid.Point, bool) {
+	var nextPosition grid.Point
+	var hasAvailableDirection bool
+
+	d := e.firstDirection
+	for i := 0; i < 4; i++ {
+		look := looks[d]
+		if g.At(p.Add(look[0])) == nil && g.At(p.Add(look[1])) == nil && g.At(p.Add(look[2])) == nil {
+			nextPosition = p.Add(look[1])
+			hasAvailableDirection = true
+			break
+		}
+
+		d = (d + 1) % 4
+	}
+
+	e.firstDirection = (e.firstDirection + 1) % 4
+
+	return nextPosition, hasAvailableDirection
+}
+
+func proposedPositions(g *grove) map[grid.Point][]grid.Point {
+	proposedPositions := map[grid.Point][]grid.Point{}
+	g.Each(func(p grid.Point, e *elf) {
+		if e == nil {
+			return
+		}
+		if g.allClear(p) {
+			e.firstDirection = (e.firstDirection + 1) % 4
+			return
+		}
+
+		if nextP, ok := g.nextPosition(p, e); ok {
+			proposedPositions[nextP] = append(proposedPositions[nextP], p)
+		}
+	})
+
+	return proposedPositions
+}
+
+func (g *grove) move(to, from grid.Point) {
+	g.Set(to, g.At(from))
+	g.Delete(from)
+}
+
+func (g *grove) moveAll(proposed map[grid.Point][]grid.Point) {
+	edge := false
+	for p, v := range proposed {
+		// if more than one elf proposed this position, skip
+		if len(v) > 1 {
+			continue
+		}
+		g.move(p, v[0])
+		edge = edge || g.onEdge(p)
+	}
+
+	if edge {
+		g.grow(g.Grid)
+	}
+}
+
+type solver struct {
+	rows []string
+
+	rec anim.Recorder
+}
+
+func (s *solver) Record(r anim.Recorder) {
+	s.rec = r
+}
+
+// frame sends the grove to the recorder, if there is one.
+func (s *solver) frame(g *grove) {
+	if s.rec != nil {
+		s.rec.Frame(anim.Capture(g.display))
+	}
+}
+
+func (s *solver) Parse(in string) error {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return aoc.EmptyError()
+	}
+
+	s.rows = nil
+	for _, l := range lines {
+		if i := strings.IndexFunc(l.Text, func(r rune) bool { return r != '.' && r != '#' }); i >= 0 {
+			return l.ErrorAt(i, l.Text[i:i+1], errors.New("expected an elf # or ground ."))
+		}
+
+		s.rows = append(s.rows, l.Text)
+	}
+
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	grove := newGrove(s.rows)
+
+	rounds := 10
+
+	s.frame(grove)
+	for round := 0; round < rounds; round++ {
+		proposedPositions := proposedPositions(grove)
+		grove.moveAll(proposedPositions)
+		s.frame(grove)
+	}
+
+	return aoc.Int(grove.countGround()), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return s.Part2Context(context.Background())
+}
+
+func (s *solver) Part1Context(context.Context) (aoc.Answer, error) {
+	return s.Part1()
+}
+
+// Part2Context runs rounds until no elf moves, which can take a long time
+// for a big grove, so it gives up when ctx is done.
+func (s *solver) Part2Context(ctx context.Context) (aoc.Answer, error) {
+	grove := newGrove(s.rows)
+
+	rounds := 1
+	s.frame(grove)
+	ck := aoc.NewCheckpoint(ctx, 1)
+	for {
+		if ck.Done() {
+			return aoc.Answer{}, fmt.Errorf("elves still moving after %d rounds: %w", rounds-1, ck.Err())
+		}
+
+		proposedPositions := proposedPositions(grove)
+		if len(proposedPositions) == 0 {
+			break
+		}
+
+		grove.moveAll(proposedPositions)
+		s.frame(grove)
+		rounds++
+	}
+
+	return aoc.Int(rounds), nil
+}
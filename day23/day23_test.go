@@ -0,0 +1,24 @@
+package day23
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 23, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(110), Part2: aoctest.Int(20)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 23, []aoctest.BadInput{
+		{Name: "bad tile", Input: "#.#\n.x.\n", Line: 2, Column: 2},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 23) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 23, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 23, 2) }
@@ -0,0 +1,261 @@
+package day24
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/graph"
+	"github.com/nickshine/adventofcode2022/grid"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:           24,
+		Input:         Input,
+		Example:       ExampleInput,
+		Params:        aoc.Params{"time": 800},
+		ExampleParams: aoc.Params{"time": 60},
+		New: func(p aoc.Params) aoc.Solver {
+			return &solver{maxTime: p["time"]}
+		},
+	})
+}
+
+// valley is the map of walls # and ground . that the blizzards blow through.
+type valley struct {
+	*grid.Grid[rune]
+}
+
+func (v valley) isWall(p grid.Point) bool {
+	return v.At(p) == '#'
+}
+
+type state struct {
+	p    grid.Point
+	time int
+}
+
+// blizzardDirs are the directions each kind of blizzard blows.
+var blizzardDirs = map[rune]grid.Point{
+	'^': grid.Up,
+	'v': grid.Down,
+	'<': grid.Left,
+	'>': grid.Right,
+}
+
+func parseInput(in string) (valley, map[grid.Point][]rune, error) {
+	rows := aoc.Lines(in)
+	if len(rows) == 0 {
+		return valley{}, nil, aoc.EmptyError()
+	}
+	if len(rows) < 3 || len(rows[0].Text) < 3 {
+		return valley{}, nil, rows[0].Error("", errors.New("valley must be at least 3x3 including its walls"))
+	}
+
+	tiles, err := grid.ParseLines(rows, func(r rune) (rune, error) {
+		if _, ok := blizzardDirs[r]; !ok && r != '#' && r != '.' {
+			return 0, errors.New("expected a wall #, ground . or a blizzard ^, v, < or >")
+		}
+		return r, nil
+	})
+	if err != nil {
+		return valley{}, nil, err
+	}
+
+	v := valley{tiles}
+	blizzards := map[grid.Point][]rune{}
+	width, height := v.Width(), v.Height()
+
+	for y, row := range rows {
+		for x, c := range row.Text {
+			if _, ok := blizzardDirs[c]; !ok {
+				continue
+			}
+			// blizzards wrap around inside the walls
+			if x == 0 || y == 0 || x == width-1 || y == height-1 {
+				return valley{}, nil, row.ErrorAt(x, string(c), errors.New("blizzard outside the valley walls"))
+			}
+			p := grid.Point{X: x, Y: y}
+			blizzards[p] = append(blizzards[p], c)
+			v.Set(p, '.')
+		}
+	}
+
+	// the expedition enters at the top left and leaves at the bottom right
+	if v.isWall(grid.Point{X: 1, Y: 0}) {
+		return valley{}, nil, rows[0].ErrorAt(1, "#", errors.New("expected the entrance"))
+	}
+	if last := height - 1; v.isWall(grid.Point{X: width - 2, Y: last}) {
+		return valley{}, nil, rows[last].ErrorAt(width-2, "#", errors.New("expected the exit"))
+	}
+
+	return v, blizzards, nil
+}
+
+// display draws the valley with its blizzards and the expedition E.
+func (v valley) display(w io.Writer, blizzards map[grid.Point][]rune, e grid.Point) {
+	v.Render(w, func(p grid.Point, c rune) rune {
+		switch {
+		case p == e:
+			return 'E'
+		case c == '#':
+			return '#'
+		case len(blizzards[p]) == 1:
+			return blizzards[p][0]
+		case len(blizzards[p]) > 1:
+			return rune('0' + len(blizzards[p]))
+		default:
+			return '.'
+		}
+	})
+}
+
+func (v valley) nextBlizzards(blizzards map[grid.Point][]rune) map[grid.Point][]rune {
+	nextBlizzards := map[grid.Point][]rune{}
+
+	for p, b := range blizzards {
+		for _, blizzard := range b {
+			next := p.Add(blizzardDirs[blizzard])
+			if v.isWall(next) {
+				switch blizzard {
+				case '^':
+					next.Y = v.Height() - 2
+				case 'v':
+					next.Y = 1
+				case '<':
+					next.X = v.Width() - 2
+				case '>':
+					next.X = 1
+				}
+			}
+
+			nextBlizzards[next] = append(nextBlizzards[next], blizzard)
+		}
+	}
+
+	return nextBlizzards
+}
+
+func (v valley) allBlizzards(blizzards map[grid.Point][]rune, maxTime int) map[int]map[grid.Point][]rune {
+
+	allBlizzards := map[int]map[grid.Point][]rune{}
+	for i := 0; i < maxTime; i++ {
+		allBlizzards[i] = blizzards
+		blizzards = v.nextBlizzards(blizzards)
+	}
+
+	return allBlizzards
+}
+
+// moves are the choices the expedition has each minute: step in a direction,
+// or wait.
+var moves = append([]grid.Point{{}}, grid.Neighbors4...)
+
+// bfs returns the quickest path of the expedition from start, leaving at time,
+// to end, or nil if it can't get there within the blizzards computed.
+func bfs(time int, v valley, start, end grid.Point, blizzards map[int]map[grid.Point][]rune) []state {
+	buf := make([]state, 0, len(moves))
+	next := func(cur state) []state {
+		if cur.time+1 >= len(blizzards) { // out of precomputed blizzards
+			return nil
+		}
+
+		out := buf[:0]
+		for _, p := range v.Neighbors(cur.p, moves) {
+			if v.isWall(p) {
+				continue
+			}
+			if _, ok := blizzards[cur.time+1][p]; ok {
+				continue
+			}
+			out = append(out, state{p, cur.time + 1})
+		}
+		return out
+	}
+
+	r := graph.BFS([]state{{start, time}}, next, func(s state) bool {
+		return s.p == end
+	})
+	if !r.Found {
+		return nil
+	}
+
+	return r.Path(r.Goal)
+}
+
+type solver struct {
+	maxTime int // minutes of blizzard movement to precompute
+
+	valley       valley
+	allBlizzards map[int]map[grid.Point][]rune
+	start, end   grid.Point
+
+	rec anim.Recorder
+}
+
+func (s *solver) Record(r anim.Recorder) {
+	s.rec = r
+}
+
+func (s *solver) Parse(in string) error {
+	v, blizzards, err := parseInput(in)
+	if err != nil {
+		return err
+	}
+	s.valley = v
+	s.allBlizzards = v.allBlizzards(blizzards, s.maxTime)
+	s.start = grid.Point{X: 1, Y: 0}
+	s.end = grid.Point{X: v.Width() - 2, Y: v.Height() - 1}
+
+	return nil
+}
+
+// trip returns the time the expedition reaches to after leaving from at time.
+func (s *solver) trip(time int, from, to grid.Point) (int, error) {
+	path := bfs(time, s.valley, from, to, s.allBlizzards)
+	if path == nil {
+		return 0, fmt.Errorf("no path from %v to %v within %d minutes", from, to, s.maxTime)
+	}
+
+	if s.rec != nil {
+		if time > 0 { // the previous trip ended with the first minute of this one
+			path = path[1:]
+		}
+		for _, st := range path {
+			s.rec.Frame(anim.Capture(func(w io.Writer) { s.valley.display(w, s.allBlizzards[st.time], st.p) }))
+		}
+	}
+
+	return path[len(path)-1].time, nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	t, err := s.trip(0, s.start, s.end)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+
+	return aoc.Int(t), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	t := 0
+	for _, leg := range [][2]grid.Point{{s.start, s.end}, {s.end, s.start}, {s.start, s.end}} {
+		var err error
+		if t, err = s.trip(t, leg[0], leg[1]); err != nil {
+			return aoc.Answer{}, err
+		}
+	}
+
+	return aoc.Int(t), nil
+}
@@ -0,0 +1,25 @@
+package day24
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 24, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(18), Part2: aoctest.Int(54)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 24, []aoctest.BadInput{
+		{Name: "bad tile", Input: "#.###\n#>.a#\n###.#\n", Line: 2, Column: 4},
+		{Name: "ragged", Input: "#.###\n#>.#\n###.#\n", Line: 2, Column: 1},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 24) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 24, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 24, 2) }
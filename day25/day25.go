@@ -0,0 +1,150 @@
+package day25
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     25,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+var snafuMap = map[byte]int{'2': 2, '1': 1, '0': 0, '-': -1, '=': -2}
+var decMap = map[int]byte{2: '2', 1: '1', 0: '0', -1: '-', -2: '='}
+
+func toDecimal(snafu string) int {
+	d := 0
+	for i, place := len(snafu)-1, 1; i >= 0; i, place = i-1, place*5 {
+		d += snafuMap[snafu[i]] * place
+	}
+	return d
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func toSnafu(d int) string {
+	var b strings.Builder
+
+	// find start place
+	var min, max int
+	place := 1
+	max = 2*place + place/2
+
+	for abs(d) > max {
+		place *= 5
+		max = 2*place + place/2
+	}
+
+	// determine digit for each place to 0
+	for ; place > 0; place /= 5 {
+		sign := 1
+		if d < 0 {
+			sign = -1
+		}
+
+		min = place - place/2   // place - place/2
+		max = 2*place + place/2 // 2*place + place/2
+
+		switch {
+		case abs(d) > max-place: // digit is 2 or -2
+			d -= 2 * place * sign
+			b.WriteByte(decMap[2*sign])
+		case abs(d) >= min: // digit is 1 or -1
+			d -= place * sign
+			b.WriteByte(decMap[sign])
+		default: // digit is 0
+			b.WriteByte(decMap[0])
+		}
+	}
+
+	return b.String()
+}
+
+// toSnafuBetter writes d from its least significant digit up, borrowing from
+// the next place for a 3 or a 4.
+func toSnafuBetter(d int) string {
+	if d == 0 {
+		return "0"
+	}
+
+	var s string
+
+	const base = 5
+
+	for d != 0 {
+		digit := (d%base + base) % base // 0 to 4, even when d is negative
+		if digit > 2 {
+			digit -= base
+		}
+
+		s = string(decMap[digit]) + s
+		d = (d - digit) / base
+	}
+
+	return s
+}
+
+var trace = aoc.Tracer(25)
+
+type solver struct {
+	snafus []string
+}
+
+func (s *solver) Parse(in string) error {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return aoc.EmptyError()
+	}
+
+	s.snafus = nil
+	for _, l := range lines {
+		snafu := strings.TrimSpace(l.Text)
+		if snafu == "" {
+			return l.Error("", errors.New("expected a SNAFU number"))
+		}
+		if i := strings.IndexFunc(snafu, func(r rune) bool { return !strings.ContainsRune("210-=", r) }); i >= 0 {
+			lead := len(l.Text) - len(strings.TrimLeft(l.Text, " \t"))
+			return l.ErrorAt(lead+i, snafu[i:i+1], errors.New("expected a SNAFU digit 2, 1, 0, - or ="))
+		}
+
+		s.snafus = append(s.snafus, snafu)
+	}
+
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	total := 0
+	for _, s := range s.snafus {
+		total += toDecimal(s)
+	}
+
+	trace.Debug("total", "decimal", total, "snafu traditional", toSnafuBetter(total))
+	return aoc.Text(toSnafu(total)), nil
+}
+
+// Part2 has no puzzle; the last star is given for finishing the others.
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNoPart
+}
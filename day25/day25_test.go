@@ -0,0 +1,66 @@
+package day25
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 25, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Text("2=-1=0")},
+	})
+}
+
+var snafuTests = []struct {
+	decimal int
+	snafu   string
+}{
+	{-3, "-2"},
+	{-1, "-"},
+	{0, "0"},
+	{1, "1"},
+	{2, "2"},
+	{3, "1="},
+	{4, "1-"},
+	{5, "10"},
+	{6, "11"},
+	{7, "12"},
+	{8, "2="},
+	{9, "2-"},
+	{10, "20"},
+	{15, "1=0"},
+	{20, "1-0"},
+	{2022, "1=11-2"},
+	{12345, "1-0---0"},
+	{314159265, "1121-1110-1=0"},
+}
+
+func TestToSnafu(t *testing.T) {
+	for _, tt := range snafuTests {
+		if got := toSnafu(tt.decimal); got != tt.snafu {
+			t.Errorf("toSnafu(%d) = %q, want %q", tt.decimal, got, tt.snafu)
+		}
+		if got := toSnafuBetter(tt.decimal); got != tt.snafu {
+			t.Errorf("toSnafuBetter(%d) = %q, want %q", tt.decimal, got, tt.snafu)
+		}
+	}
+}
+
+func TestToDecimal(t *testing.T) {
+	for _, tt := range snafuTests {
+		if got := toDecimal(tt.snafu); got != tt.decimal {
+			t.Errorf("toDecimal(%q) = %d, want %d", tt.snafu, got, tt.decimal)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 25, []aoctest.BadInput{
+		{Name: "bad digit", Input: "1=\n12x\n", Line: 2, Column: 3},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 25) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 25, 1) }
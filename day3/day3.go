@@ -0,0 +1,50 @@
+package day3
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     3,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+type solver struct {
+	rucksacks []string
+}
+
+func (s *solver) Parse(in string) error {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return aoc.EmptyError()
+	}
+
+	for _, l := range lines {
+		if i := strings.IndexFunc(l.Text, func(r rune) bool { return !strings.ContainsRune(chars, r) }); i >= 0 {
+			return l.ErrorAt(i, l.Text[i:i+1], errors.New("expected an item a-z or A-Z"))
+		}
+		if len(l.Text)%2 != 0 {
+			return l.Error(l.Text, errors.New("compartments are not the same size"))
+		}
+
+		s.rucksacks = append(s.rucksacks, l.Text)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package day3
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 3, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(157), Part2: aoctest.Int(70)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 3, []aoctest.BadInput{
+		{Name: "not an item", Input: "vJrwpWtwJgWrhcsFMMfFFhFp\nab1d\n", Line: 2, Column: 3},
+		{Name: "odd length", Input: "abc\n", Line: 1, Column: 1},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 3) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 3, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 3, 2) }
@@ -1,9 +1,9 @@
-package main
+package day3
 
 import (
-	"fmt"
-	"os"
 	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
 )
 
 const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
@@ -24,12 +24,9 @@ func sharedItem(rucksack string) string {
 	return ""
 }
 
-func main() {
-	data, _ := os.ReadFile(os.Args[1])
-	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
-
+func (s *solver) Part1() (aoc.Answer, error) {
 	total := 0
-	for _, rucksack := range lines {
+	for _, rucksack := range s.rucksacks {
 
 		shared := sharedItem(rucksack)
 
@@ -37,5 +34,5 @@ func main() {
 		total += priority
 	}
 
-	fmt.Println(total)
+	return aoc.Int(total), nil
 }
@@ -1,12 +1,10 @@
-package main
+package day3
 
 import (
-	"fmt"
-	"os"
 	"strings"
-)
 
-const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	"github.com/nickshine/adventofcode2022/aoc"
+)
 
 // groups chucks lines in to groups of 3
 func groups(lines []string) [][]string {
@@ -40,7 +38,7 @@ func set(s string) string {
 	return string(result)
 }
 
-func sharedItem(group []string) string {
+func groupSharedItem(group []string) string {
 	seen := make(map[rune]int)
 	for _, rucksack := range group {
 		for _, r := range set(rucksack) {
@@ -57,18 +55,15 @@ func sharedItem(group []string) string {
 	return ""
 }
 
-func main() {
-	data, _ := os.ReadFile(os.Args[1])
-	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
-
+func (s *solver) Part2() (aoc.Answer, error) {
 	total := 0
-	for _, group := range groups(lines) {
+	for _, group := range groups(s.rucksacks) {
 
-		shared := sharedItem(group)
+		shared := groupSharedItem(group)
 		priority := strings.Index(chars, shared) + 1
 		total += priority
 
 	}
 
-	fmt.Println(total)
+	return aoc.Int(total), nil
 }
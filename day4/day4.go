@@ -0,0 +1,123 @@
+package day4
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     4,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+// pair holds the low and high section of the left and right assignment.
+type pair struct {
+	ll, lh, rl, rh int
+}
+
+// parsePair return low and hi for each in pair
+func parsePair(l aoc.Line) (pair, error) {
+	left, right, ok := strings.Cut(l.Text, ",")
+	if !ok {
+		return pair{}, l.Error(l.Text, errors.New("expected two assignments separated by a comma"))
+	}
+
+	var p pair
+	var err error
+	if p.ll, p.lh, err = parseRange(l, 0, left); err != nil {
+		return pair{}, err
+	}
+	if p.rl, p.rh, err = parseRange(l, len(left)+1, right); err != nil {
+		return pair{}, err
+	}
+
+	return p, nil
+}
+
+// parseRange returns the low and high section of an assignment such as 2-4,
+// found at offset i of l.
+func parseRange(l aoc.Line, i int, r string) (int, int, error) {
+	low, high, ok := strings.Cut(r, "-")
+	if !ok {
+		return 0, 0, l.ErrorAt(i, r, errors.New("expected a range such as 2-4"))
+	}
+
+	lo, err := l.AtoiAt(i, low)
+	if err != nil {
+		return 0, 0, err
+	}
+	hi, err := l.AtoiAt(i+len(low)+1, high)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return lo, hi, nil
+}
+
+func (p pair) contained() bool {
+	// left contains right, or right contains left
+	return p.ll >= p.rl && p.lh <= p.rh || p.rl >= p.ll && p.rh <= p.lh
+}
+
+func (p pair) overlapped() bool {
+	// if left high overlaps right, or right high overlaps left
+	return p.lh >= p.rl && p.lh <= p.rh || p.rh >= p.ll && p.rh <= p.lh
+}
+
+type solver struct {
+	pairs []pair
+}
+
+func (s *solver) Parse(in string) error {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return aoc.EmptyError()
+	}
+
+	for _, l := range lines {
+		p, err := parsePair(l)
+		if err != nil {
+			return err
+		}
+		s.pairs = append(s.pairs, p)
+	}
+
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	var count int
+	for _, p := range s.pairs {
+		if p.contained() {
+			count++
+		}
+	}
+
+	return aoc.Int(count), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	var count int
+	for _, p := range s.pairs {
+		if p.overlapped() {
+			count++
+		}
+	}
+
+	return aoc.Int(count), nil
+}
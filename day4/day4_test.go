@@ -0,0 +1,26 @@
+package day4
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 4, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(2), Part2: aoctest.Int(4)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 4, []aoctest.BadInput{
+		{Name: "bad section", Input: "2-4,6-8\n2-3,4-x\n", Line: 2, Column: 7},
+		{Name: "no comma", Input: "2-4;6-8\n", Line: 1, Column: 1},
+		{Name: "no range", Input: "2-4,6\n", Line: 1, Column: 5},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 4) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 4, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 4, 2) }
@@ -0,0 +1,180 @@
+package day5
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     5,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+type stack struct {
+	v []string
+}
+
+func (s *stack) pop(n int) []string {
+	items := s.v[len(s.v)-n:]
+	s.v = s.v[:len(s.v)-n]
+	return items
+}
+
+func (s *stack) push(items ...string) {
+	s.v = append(s.v, items...)
+}
+
+// returns a slice of stacks
+func parseStacks(lines []aoc.Line) ([]stack, error) {
+	// total stack count is the last number on the last line, which numbers
+	// the stacks from 1
+	last := lines[len(lines)-1]
+	fields := last.Fields()
+	if len(fields) == 0 {
+		return nil, last.Error("", errors.New("expected stack numbers"))
+	}
+	for i, f := range fields {
+		n, err := last.AtoiAt(f.Offset, f.Text)
+		if err != nil {
+			return nil, err
+		}
+		if n != i+1 {
+			return nil, last.ErrorAt(f.Offset, f.Text, fmt.Errorf("expected stack number %d", i+1))
+		}
+	}
+	stackCount := len(fields)
+
+	stacks := make([]stack, stackCount)
+	// start from bottom of stacks
+	for i := len(lines) - 2; i >= 0; i-- {
+		l := lines[i].Text
+		// scan each crate
+		for j, idx := 1, 0; j < len(l); j, idx = j+4, idx+1 {
+			crate := string(l[j])
+			if crate == " " {
+				continue
+			}
+			if idx >= stackCount {
+				return nil, lines[i].ErrorAt(j, crate, fmt.Errorf("crate beyond the last stack %d", stackCount))
+			}
+			stacks[idx].push(crate)
+		}
+	}
+
+	return stacks, nil
+}
+
+func parseSteps(lines []aoc.Line, stackCount int) ([][]int, error) {
+	steps := make([][]int, len(lines))
+	for i, l := range lines {
+		f := l.Fields()
+		if len(f) != 6 || f[0].Text != "move" || f[2].Text != "from" || f[4].Text != "to" {
+			return nil, l.Error(l.Text, errors.New("expected a step such as \"move 1 from 2 to 3\""))
+		}
+
+		step := make([]int, 3)
+		for j := range step {
+			n, err := l.AtoiAt(f[2*j+1].Offset, f[2*j+1].Text)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case j == 0 && n < 0:
+				return nil, l.ErrorAt(f[1].Offset, f[1].Text, errors.New("negative crate count"))
+			case j > 0 && (n < 1 || n > stackCount):
+				return nil, l.ErrorAt(f[2*j+1].Offset, f[2*j+1].Text, fmt.Errorf("no stack %d", n))
+			}
+			step[j] = n
+		}
+		steps[i] = step
+	}
+
+	return steps, nil
+}
+
+// copyStacks returns a deep copy of stacks so they can be rearranged without
+// changing the parsed input.
+func copyStacks(stacks []stack) []stack {
+	out := make([]stack, len(stacks))
+	for i, s := range stacks {
+		out[i].v = append([]string(nil), s.v...)
+	}
+
+	return out
+}
+
+func tops(s []stack) string {
+	var top []string
+	for _, stack := range s {
+		top = append(top, stack.pop(1)...)
+	}
+	return strings.Join(top, "")
+}
+
+type solver struct {
+	stacks []stack
+	steps  [][]int
+}
+
+func (s *solver) Parse(in string) error {
+	blocks := aoc.Blocks(in)
+	switch {
+	case len(blocks) == 0:
+		return aoc.EmptyError()
+	case len(blocks) != 2:
+		l := blocks[len(blocks)-1][0]
+		return l.Error("", errors.New("expected the stacks and the steps separated by a blank line"))
+	}
+
+	var err error
+	if s.stacks, err = parseStacks(blocks[0]); err != nil {
+		return err
+	}
+	s.steps, err = parseSteps(blocks[1], len(s.stacks))
+
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	stacks := copyStacks(s.stacks)
+
+	for _, step := range s.steps {
+		moves, from, to := step[0], step[1], step[2]
+
+		for i := 0; i < moves; i++ {
+			crate := stacks[from-1].pop(1)
+			stacks[to-1].push(crate...)
+		}
+	}
+
+	return aoc.Text(tops(stacks)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	stacks := copyStacks(s.stacks)
+
+	for _, step := range s.steps {
+		moves, from, to := step[0], step[1], step[2]
+
+		crates := stacks[from-1].pop(moves)
+		stacks[to-1].push(crates...)
+	}
+
+	return aoc.Text(tops(stacks)), nil
+}
@@ -0,0 +1,26 @@
+package day5
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 5, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Text("CMZ"), Part2: aoctest.Text("MCD")},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 5, []aoctest.BadInput{
+		{Name: "no such stack", Input: "    [D]    \n[N] [C]    \n[Z] [M] [P]\n 1   2   3 \n\nmove 1 from 2 to 9\n", Line: 6, Column: 18},
+		{Name: "bad count", Input: "    [D]    \n[N] [C]    \n[Z] [M] [P]\n 1   2   3 \n\nmove x from 2 to 1\n", Line: 6, Column: 6},
+		{Name: "huge stack count", Input: "[Z]\n 10000000000000\n\nmove 1 from 1 to 1\n", Line: 2, Column: 2},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 5) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 5, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 5, 2) }
@@ -0,0 +1,83 @@
+package day6
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example1.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     6,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+// Scan returns the number of characters processed before the first marker of
+// size distinct characters is found, or -1 if there is no such marker.
+func Scan(in string, size int) int {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return -1
+	}
+
+	l, r := 0, 1
+	seen := make(map[byte]int, size)
+	seen[in[l]] = l
+
+	for {
+		if r-l == size {
+			break
+		} else if r == len(in) {
+			return -1
+		}
+
+		if idx, ok := seen[in[r]]; ok && idx >= l {
+			l = idx + 1
+		}
+
+		seen[in[r]] = r
+		r++
+	}
+
+	return r
+}
+
+type solver struct {
+	buffer string
+}
+
+func (s *solver) Parse(in string) error {
+	lines := aoc.Lines(strings.TrimSpace(in))
+	switch {
+	case len(lines) == 0:
+		return aoc.EmptyError()
+	case len(lines) > 1:
+		return lines[1].Error(lines[1].Text, errors.New("expected the datastream on a single line"))
+	}
+
+	s.buffer = lines[0].Text
+	return nil
+}
+
+// Part1 finds the start-of-packet marker.
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(Scan(s.buffer, 4)), nil
+}
+
+// Part2 finds the start-of-message marker.
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(Scan(s.buffer, 14)), nil
+}
@@ -0,0 +1,90 @@
+package day6
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+	"github.com/nickshine/adventofcode2022/gen"
+)
+
+func TestSolver(t *testing.T) {
+	want := []struct{ part1, part2 int }{
+		{7, 19},
+		{5, 23},
+		{6, 23},
+		{10, 29},
+		{11, 26},
+	}
+
+	var cases []aoctest.Case
+	for i, w := range want {
+		name := fmt.Sprintf("example%d.txt", i+1)
+		in, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cases = append(cases, aoctest.Case{
+			Name:  name,
+			Input: string(in),
+			Part1: aoctest.Int(w.part1),
+			Part2: aoctest.Int(w.part2),
+		})
+	}
+
+	// a marker ending the datastream used to go unfound
+	cases = append(cases, aoctest.Case{
+		Name:  "markers at the end",
+		Input: "aaaaabcdefghijklmn\n",
+		Part1: aoctest.Int(8),
+		Part2: aoctest.Int(18),
+	})
+
+	aoctest.Run(t, 6, cases)
+}
+
+func TestScanV1(t *testing.T) {
+	inputs := []string{ExampleInput}
+	for seed := uint64(1); seed <= 10; seed++ {
+		in, err := gen.Generate(6, seed, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs = append(inputs, in.Text)
+	}
+
+	for i, in := range inputs {
+		for _, size := range []int{4, 14} {
+			if got, want := scanV1(in, size), Scan(in, size); got != want {
+				t.Errorf("input %d: scanV1(%d) = %d, want %d", i, size, got, want)
+			}
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	for _, tt := range []struct {
+		in         string
+		size, want int
+	}{
+		{"abcd", 4, 4}, // the marker ends the datastream
+		{"abca", 4, -1},
+		{"aabcd", 4, 5},
+	} {
+		if got := Scan(tt.in, tt.size); got != tt.want {
+			t.Errorf("Scan(%q, %d) = %d, want %d", tt.in, tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 6, []aoctest.BadInput{
+		{Name: "two lines", Input: "abcd\nefgh\n", Line: 2, Column: 1},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 6) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 6, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 6, 2) }
@@ -1,16 +1,13 @@
-package main
+package day6
 
 import (
-	_ "embed"
-	"fmt"
 	"strings"
 )
 
-//go:embed input.txt
-var input string
-
-func scan(size int) int {
-	in := strings.TrimSpace(input)
+// scanV1 is the first take on Scan, which restarts the window from scratch
+// whenever a duplicate is found.
+func scanV1(in string, size int) int {
+	in = strings.TrimSpace(in)
 	l, r := 0, 1
 	seen := make(map[byte]int, size)
 	seen[in[l]] = l
@@ -30,8 +27,3 @@ func scan(size int) int {
 
 	return r
 }
-
-func main() {
-	fmt.Printf("part 1: %d\n", scan(4))
-	fmt.Printf("part 2: %d\n", scan(14))
-}
@@ -0,0 +1,221 @@
+package day7
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     7,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+const (
+	fsSize    = 70000000
+	unusedMin = 30000000
+)
+
+type file struct {
+	size int
+	name string
+}
+
+// Dir is a directory in the filesystem reconstructed from a terminal transcript.
+type Dir struct {
+	name   string
+	parent *Dir
+	dirs   map[string]*Dir
+	files  map[string]*file
+}
+
+func newDir(name string, parent *Dir) *Dir {
+	return &Dir{
+		name:   name,
+		parent: parent,
+		dirs:   make(map[string]*Dir),
+		files:  make(map[string]*file),
+	}
+}
+
+// Display writes the directory tree rooted at d to w, each directory's
+// subdirectories then files in name order.
+func (d *Dir) Display(w io.Writer, indent int) {
+
+	indention := strings.Repeat(" ", indent)
+	fmt.Fprintf(w, "%s- %s (dir)\n", indention, d.name)
+	for _, name := range sortedKeys(d.dirs) {
+		d.dirs[name].Display(w, indent+2)
+	}
+
+	for _, name := range sortedKeys(d.files) {
+		f := d.files[name]
+		fmt.Fprintf(w, "%s  - %s (file, size=%d)\n", indention, f.name, f.size)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Size returns the total size of all files in d and its subdirectories.
+func (d *Dir) Size() int {
+
+	size := 0
+	for _, subDir := range d.dirs {
+		size += subDir.Size()
+	}
+
+	for _, f := range d.files {
+		size += f.size
+	}
+
+	return size
+}
+
+// BuildFS replays the cd and ls commands of a terminal transcript and returns
+// the root directory.
+func BuildFS(in string) (*Dir, error) {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return nil, aoc.EmptyError()
+	}
+
+	root := newDir("/", nil)
+	curr := root
+
+	for _, l := range lines {
+		parts := l.Fields()
+
+		switch {
+		case len(parts) == 3 && parts[0].Text == "$" && parts[1].Text == "cd":
+			name := parts[2].Text
+			switch name {
+			case "/":
+				curr = root
+			case "..":
+				if curr.parent == nil {
+					return nil, l.ErrorAt(parts[2].Offset, name, errors.New("cd above the root directory"))
+				}
+				curr = curr.parent
+			default:
+				if _, ok := curr.dirs[name]; ok {
+					curr = curr.dirs[name]
+				}
+			}
+		case len(parts) == 2 && parts[0].Text == "$" && parts[1].Text == "ls":
+		case len(parts) == 2 && parts[0].Text == "dir":
+			name := parts[1].Text
+			if _, ok := curr.dirs[name]; !ok {
+				curr.dirs[name] = newDir(name, curr)
+			}
+		case len(parts) == 2 && parts[0].Text != "$":
+			// is file
+			size, err := l.AtoiAt(parts[0].Offset, parts[0].Text)
+			if err != nil {
+				return nil, err
+			}
+			name := parts[1].Text
+			if _, ok := curr.files[name]; !ok {
+				curr.files[name] = &file{size: size, name: name}
+			}
+		default:
+			return nil, l.Error(l.Text, errors.New("expected a cd or ls command, or ls output"))
+		}
+	}
+
+	return root, nil
+}
+
+// Sizes returns the total size of d and every directory below it.
+func Sizes(d *Dir) []int {
+
+	dirSizes := []int{}
+
+	for _, subDir := range d.dirs {
+		dirSizes = append(dirSizes, Sizes(subDir)...)
+	}
+
+	return append(dirSizes, d.Size())
+}
+
+type solver struct {
+	root *Dir
+
+	rec anim.Recorder
+}
+
+// Record has each part draw the directory tree, as a single frame.
+func (s *solver) Record(r anim.Recorder) {
+	s.rec = r
+}
+
+func (s *solver) draw() {
+	if s.rec != nil {
+		s.rec.Frame(anim.Capture(func(w io.Writer) { s.root.Display(w, 0) }))
+	}
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.root, err = BuildFS(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	s.draw()
+
+	dirSizes := Sizes(s.root)
+	sum := 0
+	for _, s := range dirSizes {
+		if s <= 100000 {
+			sum += s
+		}
+	}
+
+	return aoc.Int(sum), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	s.draw()
+
+	unused := fsSize - s.root.Size()
+	deleteMin := unusedMin - unused
+
+	dirSizes := Sizes(s.root)
+	var min int
+	for _, s := range dirSizes {
+		if s >= deleteMin {
+			if min == 0 {
+				min = s
+			} else if s < min {
+				min = s
+			}
+		}
+	}
+
+	return aoc.Int(min), nil
+}
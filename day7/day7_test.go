@@ -0,0 +1,55 @@
+package day7
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 7, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(95437), Part2: aoctest.Int(24933642)},
+	})
+}
+
+func TestDisplay(t *testing.T) {
+	root, err := BuildFS(ExampleInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// as the puzzle draws it
+	want := `- / (dir)
+  - a (dir)
+    - e (dir)
+      - i (file, size=584)
+    - f (file, size=29116)
+    - g (file, size=2557)
+    - h.lst (file, size=62596)
+  - d (dir)
+    - d.ext (file, size=5626152)
+    - d.log (file, size=8033020)
+    - j (file, size=4060174)
+    - k (file, size=7214296)
+  - b.txt (file, size=14848514)
+  - c.dat (file, size=8504156)
+`
+	var b strings.Builder
+	root.Display(&b, 0)
+	if b.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 7, []aoctest.BadInput{
+		{Name: "bad size", Input: "$ cd /\n$ ls\nabc b.txt\n", Line: 3, Column: 1},
+		{Name: "cd above root", Input: "$ cd ..\n", Line: 1, Column: 6},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 7) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 7, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 7, 2) }
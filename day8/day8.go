@@ -0,0 +1,116 @@
+package day8
+
+import (
+	_ "embed"
+	"errors"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/grid"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     8,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+func readGrid(in string) (*grid.Grid[int], error) {
+	return grid.Parse(in, func(r rune) (int, error) {
+		if r < '0' || r > '9' {
+			return 0, errors.New("expected a tree height 0-9")
+		}
+		return int(r - '0'), nil
+	})
+}
+
+func calcPerimeter(g *grid.Grid[int]) int {
+	return 2*(g.Width()+g.Height()) - 4
+}
+
+// isVisible reports whether every tree from p onwards in direction d is
+// shorter than tree.
+func isVisible(g *grid.Grid[int], tree int, p, d grid.Point) bool {
+	h, ok := g.Get(p)
+	if !ok {
+		return true
+	}
+
+	return h < tree && isVisible(g, tree, p.Add(d), d)
+}
+
+func isPerimeter(g *grid.Grid[int], p grid.Point) bool {
+	return p.X == 0 || p.X == g.Width()-1 || p.Y == 0 || p.Y == g.Height()-1
+}
+
+type solver struct {
+	grid *grid.Grid[int]
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.grid, err = readGrid(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	g := s.grid
+	visible := 0
+
+	g.Each(func(p grid.Point, tree int) {
+		if isPerimeter(g, p) {
+			visible++
+			return
+		}
+
+		for _, d := range grid.Neighbors4 {
+			if isVisible(g, tree, p.Add(d), d) {
+				visible++
+				return
+			}
+		}
+	})
+
+	return aoc.Int(visible), nil
+}
+
+// score returns the number of trees visible from a tree of height tree,
+// looking from p onwards in direction d.
+func score(g *grid.Grid[int], tree int, p, d grid.Point) int {
+	h, ok := g.Get(p)
+	if !ok {
+		return 0
+	}
+
+	if h >= tree {
+		return 1
+	}
+
+	return 1 + score(g, tree, p.Add(d), d)
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	g := s.grid
+	max := 0
+	g.Each(func(p grid.Point, tree int) {
+		total := 1
+		for _, d := range grid.Neighbors4 {
+			total *= score(g, tree, p.Add(d), d)
+		}
+		if total > max {
+			max = total
+		}
+	})
+
+	return aoc.Int(max), nil
+}
@@ -0,0 +1,25 @@
+package day8
+
+import (
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	aoctest.Run(t, 8, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(21), Part2: aoctest.Int(8)},
+	})
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 8, []aoctest.BadInput{
+		{Name: "not a height", Input: "123\n1a3\n", Line: 2, Column: 2},
+		{Name: "ragged", Input: "123\n12\n", Line: 2, Column: 1},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 8) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 8, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 8, 2) }
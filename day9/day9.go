@@ -0,0 +1,160 @@
+package day9
+
+import (
+	_ "embed"
+	"errors"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+//go:embed example.txt
+var ExampleInput string
+
+//go:embed input.txt
+var Input string
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Day:     9,
+		Input:   Input,
+		Example: ExampleInput,
+		New: func(aoc.Params) aoc.Solver {
+			return &solver{}
+		},
+	})
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+type knot struct {
+	x int
+	y int
+}
+
+func move(h, t *knot) {
+
+	xd := h.x - t.x
+	yd := h.y - t.y
+
+	switch {
+	case abs(xd) < 2 && abs(yd) < 2: // t equal or adjacent to h
+		return
+	case xd == 0 && yd != 0: // t moves U or D
+		if yd > 1 {
+			t.y++
+		} else {
+			t.y--
+		}
+	case yd == 0 && xd != 0: // t moves L or R
+		if xd > 1 {
+			t.x++
+		} else {
+			t.x--
+		}
+
+	default: // t moves diagonal
+		if yd > 0 {
+			t.y++
+		} else {
+			t.y--
+		}
+		if xd > 0 {
+			t.x++
+		} else {
+			t.x--
+		}
+
+	}
+}
+
+func travel(rope []*knot, dx, dy, n int, seen map[knot]bool) {
+
+	h, t := rope[0], rope[len(rope)-1]
+	for m := 0; m < n; m++ {
+		h.x, h.y = h.x+dx, h.y+dy
+
+		for i := 1; i < len(rope); i++ {
+			move(rope[i-1], rope[i])
+		}
+		seen[*t] = true
+	}
+}
+
+// motion moves the head n steps by dx, dy.
+type motion struct {
+	dx, dy, n int
+}
+
+func parseMotions(in string) ([]motion, error) {
+	lines := aoc.Lines(in)
+	if len(lines) == 0 {
+		return nil, aoc.EmptyError()
+	}
+
+	motions := make([]motion, 0, len(lines))
+	for _, l := range lines {
+		parts := l.Fields()
+		if len(parts) != 2 {
+			return nil, l.Error(l.Text, errors.New("expected a motion such as \"R 4\""))
+		}
+
+		dir := parts[0].Text
+		n, err := l.AtoiAt(parts[1].Offset, parts[1].Text)
+		if err != nil {
+			return nil, err
+		}
+
+		switch dir {
+		case "L":
+			motions = append(motions, motion{-1, 0, n})
+		case "R":
+			motions = append(motions, motion{1, 0, n})
+		case "U":
+			motions = append(motions, motion{0, 1, n})
+		case "D":
+			motions = append(motions, motion{0, -1, n})
+		default:
+			return nil, l.ErrorAt(parts[0].Offset, dir, errors.New("expected a direction L, R, U or D"))
+		}
+	}
+
+	return motions, nil
+}
+
+func run(motions []motion, size int) int {
+	rope := make([]*knot, size)
+	for i := 0; i < len(rope); i++ {
+		rope[i] = &knot{0, 0}
+	}
+	seen := map[knot]bool{{0, 0}: true}
+
+	for _, m := range motions {
+		travel(rope, m.dx, m.dy, m.n, seen)
+	}
+
+	return len(seen)
+
+}
+
+type solver struct {
+	motions []motion
+}
+
+func (s *solver) Parse(in string) error {
+	var err error
+	s.motions, err = parseMotions(in)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(run(s.motions, 2)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(run(s.motions, 10)), nil
+}
@@ -0,0 +1,62 @@
+package day9
+
+import (
+	"os"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+)
+
+func TestSolver(t *testing.T) {
+	example2, err := os.ReadFile("example2.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aoctest.Run(t, 9, []aoctest.Case{
+		{Name: "example", Input: ExampleInput, Part1: aoctest.Int(13), Part2: aoctest.Int(1)},
+		{Name: "example2", Input: string(example2), Part2: aoctest.Int(36)},
+	})
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		name string
+		h, t knot
+		want knot
+	}{
+		{"overlapping", knot{1, 1}, knot{1, 1}, knot{1, 1}},
+		{"adjacent", knot{2, 1}, knot{1, 1}, knot{1, 1}},
+		{"diagonally adjacent", knot{2, 2}, knot{1, 1}, knot{1, 1}},
+		{"right", knot{3, 1}, knot{1, 1}, knot{2, 1}},
+		{"left", knot{1, 1}, knot{3, 1}, knot{2, 1}},
+		{"up", knot{1, 3}, knot{1, 1}, knot{1, 2}},
+		{"down", knot{1, 1}, knot{1, 3}, knot{1, 2}},
+		{"diagonal up right", knot{2, 3}, knot{1, 1}, knot{2, 2}},
+		{"diagonal down left", knot{1, 1}, knot{2, 3}, knot{1, 2}},
+		{"diagonal long way", knot{3, 2}, knot{1, 1}, knot{2, 2}},
+		{"diagonal both axes", knot{3, 3}, knot{1, 1}, knot{2, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tail := tt.t
+			move(&tt.h, &tail)
+			if tail != tt.want {
+				t.Errorf("move(%v, %v) moved tail to %v, want %v", tt.h, tt.t, tail, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	aoctest.ParseErrors(t, 9, []aoctest.BadInput{
+		{Name: "bad direction", Input: "R 4\nX 2\n", Line: 2, Column: 1},
+		{Name: "bad steps", Input: "R four\n", Line: 1, Column: 3},
+	})
+}
+
+func FuzzParse(f *testing.F) { aoctest.Fuzz(f, 9) }
+
+func BenchmarkPart1(b *testing.B) { aoctest.Benchmark(b, 9, 1) }
+func BenchmarkPart2(b *testing.B) { aoctest.Benchmark(b, 9, 2) }
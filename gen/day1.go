@@ -0,0 +1,22 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day1 generates the calories carried by size elves.
+func day1(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		for n := between(r, 1, 15); n > 0; n-- {
+			fmt.Fprintln(&b, between(r, 1000, 60000))
+		}
+	}
+
+	return text(b.String())
+}
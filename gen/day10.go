@@ -0,0 +1,32 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day10 generates a program that runs for at least size cycles, and at least
+// the 240 the CRT draws.
+func day10(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	x := 1
+	for cycles := 0; cycles < max(size, 240); {
+		if r.IntN(3) == 0 {
+			b.WriteString("noop\n")
+			cycles++
+			continue
+		}
+
+		// keep the sprite roughly on screen
+		v := between(r, -10, 10)
+		if x+v < -5 || x+v > 45 {
+			v = -v
+		}
+		x += v
+		fmt.Fprintf(&b, "addx %d\n", v)
+		cycles += 2
+	}
+
+	return text(b.String())
+}
@@ -0,0 +1,63 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// primes are the monkeys' divisors. Their product stays small enough to square
+// without overflowing, as part 2 does with "old * old".
+var primes = []int{2, 3, 5, 7, 11, 13, 17, 19}
+
+// day11 generates between two and eight monkeys holding size items between
+// them. As in real inputs, only one monkey squares the worry level, which keeps
+// part 1's levels from overflowing.
+func day11(r *rand.Rand, size int) Input {
+	n := between(r, 2, len(primes))
+	squares := r.IntN(n)
+	divisors := append([]int(nil), primes...)
+	r.Shuffle(len(divisors), func(i, j int) { divisors[i], divisors[j] = divisors[j], divisors[i] })
+
+	items := make([][]string, n)
+	for i := 0; i < size; i++ {
+		m := r.IntN(n)
+		items[m] = append(items[m], fmt.Sprint(between(r, 50, 99)))
+	}
+
+	var b strings.Builder
+	for m := 0; m < n; m++ {
+		if m > 0 {
+			b.WriteString("\n")
+		}
+
+		var op string
+		switch {
+		case m == squares:
+			op = "old * old"
+		case r.IntN(2) == 0:
+			op = fmt.Sprintf("old * %d", between(r, 2, 19))
+		default:
+			op = fmt.Sprintf("old + %d", between(r, 1, 8))
+		}
+
+		// throw to two other monkeys
+		t := r.IntN(n - 1)
+		if t >= m {
+			t++
+		}
+		f := t
+		for n > 2 && (f == t || f == m) {
+			f = r.IntN(n)
+		}
+
+		fmt.Fprintf(&b, "Monkey %d:\n", m)
+		fmt.Fprintf(&b, "  Starting items: %s\n", strings.Join(items[m], ", "))
+		fmt.Fprintf(&b, "  Operation: new = %s\n", op)
+		fmt.Fprintf(&b, "  Test: divisible by %d\n", divisors[m])
+		fmt.Fprintf(&b, "    If true: throw to monkey %d\n", t)
+		fmt.Fprintf(&b, "    If false: throw to monkey %d\n", f)
+	}
+
+	return text(b.String())
+}
@@ -0,0 +1,63 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// day12 generates a heightmap size squares wide, and at least 26 so it can
+// climb from a to z. Neighboring squares differ in height by at most one, so
+// every square can reach every other.
+func day12(r *rand.Rand, size int) Input {
+	w := max(size, 26)
+	h := max(w/3, 2)
+
+	for {
+		heights := make([][]int, h)
+		var end [2]int
+		for y := range heights {
+			heights[y] = make([]int, w)
+			for x := range heights[y] {
+				lo, hi := 0, 25
+				if x > 0 {
+					lo, hi = max(lo, heights[y][x-1]-1), min(hi, heights[y][x-1]+1)
+				}
+				if y > 0 {
+					lo, hi = max(lo, heights[y-1][x]-1), min(hi, heights[y-1][x]+1)
+				}
+				if x == 0 && y == 0 {
+					hi = 0
+				}
+
+				v := between(r, lo, hi)
+				if r.IntN(3) > 0 { // tend upwards
+					v = hi
+				}
+				heights[y][x] = v
+				if v == 25 {
+					end = [2]int{x, y}
+				}
+			}
+		}
+		if end == [2]int{} { // never reached z; try again
+			continue
+		}
+
+		var b strings.Builder
+		for y, row := range heights {
+			for x, v := range row {
+				switch {
+				case x == 0 && y == 0:
+					b.WriteByte('S')
+				case x == end[0] && y == end[1]:
+					b.WriteByte('E')
+				default:
+					b.WriteByte(byte('a' + v))
+				}
+			}
+			b.WriteString("\n")
+		}
+
+		return text(b.String())
+	}
+}
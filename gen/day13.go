@@ -0,0 +1,35 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day13 generates size pairs of packets.
+func day13(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s\n%s\n", packet(r, 0), packet(r, 0))
+	}
+
+	return text(b.String())
+}
+
+// packet returns a random list, nested at most four deep.
+func packet(r *rand.Rand, depth int) string {
+	n := r.IntN(5)
+	items := make([]string, n)
+	for i := range items {
+		if depth < 4 && r.IntN(3) == 0 {
+			items[i] = packet(r, depth+1)
+		} else {
+			items[i] = fmt.Sprint(r.IntN(11))
+		}
+	}
+
+	return "[" + strings.Join(items, ",") + "]"
+}
@@ -0,0 +1,36 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// day14 generates size rock paths under the sand source at 500,0, with the
+// cave size and offset they need. The cave is at most 480 deep, to keep every
+// x from 0 up.
+func day14(r *rand.Rand, size int) Input {
+	depth := between(r, 10, min(10+size, 480))
+	cave := 2*depth + 8 // room for the sand to pile up to the source on the floor
+	left, right := 500-depth, 500+depth
+
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		x, y := between(r, left, right), between(r, 1, depth)
+		points := []string{fmt.Sprintf("%d,%d", x, y)}
+		for n := between(r, 1, 4); n > 0; n-- {
+			d := between(r, 1, 6)
+			if r.IntN(2) == 0 {
+				x = min(max(x+d*(1-2*r.IntN(2)), left), right)
+			} else {
+				y = min(max(y+d*(1-2*r.IntN(2)), 1), depth)
+			}
+			points = append(points, fmt.Sprintf("%d,%d", x, y))
+		}
+		b.WriteString(strings.Join(points, " -> ") + "\n")
+	}
+
+	return Input{Text: b.String(), Params: aoc.Params{"size": cave, "offset": 500 - cave/2}}
+}
@@ -0,0 +1,59 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// day15 generates size sensors in a search area from 0 to 10*size, with the
+// row to scan through its middle. Each sensor reports the beacon closest to it,
+// as the puzzle promises, and sensors tied between two beacons are left out.
+func day15(r *rand.Rand, size int) Input {
+	area := 10 * size
+	dist := func(a, b [2]int) int {
+		return abs(a[0]-b[0]) + abs(a[1]-b[1])
+	}
+
+	beacons := make([][2]int, max(size/2, 1))
+	for i := range beacons {
+		beacons[i] = [2]int{between(r, -area/10, area+area/10), between(r, -area/10, area+area/10)}
+	}
+
+	var b strings.Builder
+	seen := map[[2]int]bool{}
+	for n := 0; n < size; {
+		s := [2]int{between(r, 0, area), between(r, 0, area)}
+		if seen[s] {
+			continue
+		}
+
+		closest, tied := beacons[0], false
+		for _, c := range beacons[1:] {
+			switch d, best := dist(s, c), dist(s, closest); {
+			case d < best:
+				closest, tied = c, false
+			case d == best && c != closest:
+				tied = true
+			}
+		}
+		if tied || closest == s {
+			continue
+		}
+
+		seen[s] = true
+		n++
+		fmt.Fprintf(&b, "Sensor at x=%d, y=%d: closest beacon is at x=%d, y=%d\n", s[0], s[1], closest[0], closest[1])
+	}
+
+	return Input{Text: b.String(), Params: aoc.Params{"y": area / 2, "max": area}}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
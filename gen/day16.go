@@ -0,0 +1,64 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day16 generates a connected network of size valves, up to 63, starting at
+// AA. As in real inputs, at most 15 valves have a flow rate, since the search
+// for the best order to open them grows exponentially with their number.
+func day16(r *rand.Rand, size int) Input {
+	n := min(max(size, 2), 63)
+
+	names := map[string]bool{"AA": true}
+	ids := []string{"AA"}
+	for len(ids) < n {
+		id := string([]byte{byte('A' + r.IntN(26)), byte('A' + r.IntN(26))})
+		if !names[id] {
+			names[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	// a random spanning tree, then a few extra tunnels
+	tunnels := make([]map[int]bool, n)
+	for i := range tunnels {
+		tunnels[i] = map[int]bool{}
+	}
+	connect := func(a, b int) {
+		if a != b {
+			tunnels[a][b], tunnels[b][a] = true, true
+		}
+	}
+	for i := 1; i < n; i++ {
+		connect(i, r.IntN(i))
+	}
+	for i := 0; i < n/4; i++ {
+		connect(r.IntN(n), r.IntN(n))
+	}
+
+	flowing := r.Perm(n - 1)[:min(15, n/3+1)]
+	rates := make([]int, n)
+	for _, i := range flowing {
+		rates[i+1] = between(r, 1, 25) // never AA
+	}
+
+	var b strings.Builder
+	for i, id := range ids {
+		var to []string
+		for j := 0; j < n; j++ {
+			if tunnels[i][j] {
+				to = append(to, ids[j])
+			}
+		}
+		if len(to) == 1 {
+			fmt.Fprintf(&b, "Valve %s has flow rate=%d; tunnel leads to valve %s\n", id, rates[i], to[0])
+		} else {
+			fmt.Fprintf(&b, "Valve %s has flow rate=%d; tunnels lead to valves %s\n", id, rates[i], strings.Join(to, ", "))
+		}
+	}
+
+	return text(b.String())
+}
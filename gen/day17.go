@@ -0,0 +1,17 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// day17 generates a jet pattern of size jets.
+func day17(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		b.WriteByte("<>"[r.IntN(2)])
+	}
+	b.WriteString("\n")
+
+	return text(b.String())
+}
@@ -0,0 +1,29 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day18 generates size different cubes of lava, clustered in a space about
+// twice as wide as their cube root so they enclose some air pockets.
+func day18(r *rand.Rand, size int) Input {
+	side := 2
+	for side*side*side < 8*size {
+		side++
+	}
+
+	var b strings.Builder
+	seen := map[[3]int]bool{}
+	for len(seen) < size {
+		c := [3]int{r.IntN(side), r.IntN(side), r.IntN(side)}
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		fmt.Fprintf(&b, "%d,%d,%d\n", c[0], c[1], c[2])
+	}
+
+	return text(b.String())
+}
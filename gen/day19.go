@@ -0,0 +1,18 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day19 generates size blueprints with costs in the ranges real inputs use.
+func day19(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for id := 1; id <= size; id++ {
+		fmt.Fprintf(&b, "Blueprint %d: Each ore robot costs %d ore. Each clay robot costs %d ore. Each obsidian robot costs %d ore and %d clay. Each geode robot costs %d ore and %d obsidian.\n",
+			id, between(r, 2, 4), between(r, 2, 4), between(r, 2, 4), between(r, 4, 20), between(r, 2, 4), between(r, 7, 20))
+	}
+
+	return text(b.String())
+}
@@ -0,0 +1,17 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day2 generates a strategy guide of size rounds.
+func day2(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		fmt.Fprintf(&b, "%c %c\n", 'A'+r.IntN(3), 'X'+r.IntN(3))
+	}
+
+	return text(b.String())
+}
@@ -0,0 +1,26 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day20 generates size numbers to mix, exactly one of them 0, with duplicates
+// as in real inputs. There are at least two, as there's nothing to mix one
+// number with.
+func day20(r *rand.Rand, size int) Input {
+	size = max(size, 2)
+	zero := r.IntN(size)
+
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		n := 0
+		for i != zero && n == 0 {
+			n = between(r, -10000, 10000)
+		}
+		fmt.Fprintf(&b, "%d\n", n)
+	}
+
+	return text(b.String())
+}
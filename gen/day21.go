@@ -0,0 +1,114 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// day21 generates about size monkeys. Only +, - and * by a small number lie
+// between humn and root, so a single humn number balances root, and the
+// parameters search a range around it.
+func day21(r *rand.Rand, size int) Input {
+	m := &monkeys21{r: r, names: map[string]bool{"root": true, "humn": true}}
+	n := max(size, 10)
+
+	// a*h + b is the number the humn side of root yells when humn yells h
+	a, b := 1, 0
+	path := "humn"
+
+	steps := min(max(n/10, 1), 40)
+	for i, muls := 0, 0; i < steps; i++ {
+		name := m.name()
+		switch op := r.IntN(3); {
+		case op == 2 && muls < 20:
+			muls++
+			k := between(r, 2, 3)
+			other := m.yell(k)
+			m.job(name, path, "*", other)
+			a, b = a*k, b*k
+		default:
+			other, v := m.tree(between(r, 1, n/(steps+1)+1))
+			if r.IntN(2) == 0 {
+				m.job(name, path, "+", other)
+				b += v
+			} else if r.IntN(2) == 0 {
+				m.job(name, path, "-", other)
+				b -= v
+			} else {
+				m.job(name, other, "-", path)
+				a, b = -a, v-b
+			}
+		}
+		path = name
+	}
+
+	answer := between(r, size, 10*size+1000)
+	sub, v := m.tree(between(r, 1, n/(steps+1)+1))
+	other := m.name()
+	m.job(other, sub, "+", m.yell(a*answer+b-v))
+	if r.IntN(2) == 0 {
+		m.job("root", path, "+", other)
+	} else {
+		m.job("root", other, "+", path)
+	}
+	m.lines = append(m.lines, fmt.Sprintf("humn: %d", between(r, 1, 9)))
+
+	r.Shuffle(len(m.lines), func(i, j int) { m.lines[i], m.lines[j] = m.lines[j], m.lines[i] })
+
+	return Input{
+		Text:   strings.Join(m.lines, "\n") + "\n",
+		Params: aoc.Params{"start": answer - between(r, 0, size), "end": answer + 1 + between(r, 0, size)},
+	}
+}
+
+type monkeys21 struct {
+	r     *rand.Rand
+	names map[string]bool
+	lines []string
+}
+
+func (m *monkeys21) name() string {
+	for {
+		var b [4]byte
+		for i := range b {
+			b[i] = byte('a' + m.r.IntN(26))
+		}
+		if name := string(b[:]); !m.names[name] {
+			m.names[name] = true
+			return name
+		}
+	}
+}
+
+func (m *monkeys21) yell(n int) string {
+	name := m.name()
+	m.lines = append(m.lines, fmt.Sprintf("%s: %d", name, n))
+	return name
+}
+
+func (m *monkeys21) job(name, a, op, b string) {
+	m.lines = append(m.lines, fmt.Sprintf("%s: %s %s %s", name, a, op, b))
+}
+
+// tree adds n monkeys, returning the one at the top and the number it yells.
+// They only add and subtract, to keep the numbers small.
+func (m *monkeys21) tree(n int) (string, int) {
+	if n < 3 {
+		v := between(m.r, 1, 9)
+		return m.yell(v), v
+	}
+
+	k := between(m.r, 1, n-2)
+	a, av := m.tree(k)
+	b, bv := m.tree(n - 1 - k)
+	name := m.name()
+	if m.r.IntN(2) == 0 {
+		m.job(name, a, "+", b)
+		return name, av + bv
+	}
+	m.job(name, a, "-", b)
+	return name, av - bv
+}
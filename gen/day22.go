@@ -0,0 +1,47 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// day22 generates a path of size steps across a map with the same faces as the
+// puzzle input, 50 tiles square, since part 2 only knows how to fold that map
+// and the example's.
+func day22(r *rand.Rand, size int) Input {
+	const face = 50
+	faces := [][2]int{{1, 0}, {2, 0}, {1, 1}, {0, 2}, {1, 2}, {0, 3}} // column and row of each face
+
+	var rows [4 * face][]byte
+	for _, f := range faces {
+		for y := f[1] * face; y < (f[1]+1)*face; y++ {
+			for len(rows[y]) < (f[0]+1)*face {
+				rows[y] = append(rows[y], ' ')
+			}
+			for x := f[0] * face; x < (f[0]+1)*face; x++ {
+				rows[y][x] = '.'
+				if r.IntN(10) == 0 {
+					rows[y][x] = '#'
+				}
+			}
+		}
+	}
+	rows[0][face] = '.' // the start
+
+	var b strings.Builder
+	for _, row := range rows {
+		b.Write(row)
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	fmt.Fprint(&b, between(r, 1, face))
+	for i := 1; i < size; i++ {
+		fmt.Fprintf(&b, "%c%d", "LR"[r.IntN(2)], between(r, 1, face))
+	}
+	b.WriteString("\n")
+
+	return Input{Text: b.String(), Params: aoc.Params{"face": face}}
+}
@@ -0,0 +1,19 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// day23 generates a size by size grove about half full of elves.
+func day23(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			b.WriteByte(".#"[r.IntN(2)])
+		}
+		b.WriteString("\n")
+	}
+
+	return text(b.String())
+}
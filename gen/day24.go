@@ -0,0 +1,37 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// day24 generates a valley size tiles wide inside its walls and a third as
+// tall, with blizzards on a third of its tiles, fewer than real inputs have, so
+// that it's likely passable. No blizzard blows up or down through the entrance or
+// exit, as in real inputs.
+func day24(r *rand.Rand, size int) Input {
+	w, h := max(size, 3), max(size/3, 3)
+
+	var b strings.Builder
+	b.WriteString("#." + strings.Repeat("#", w) + "\n")
+	for y := 0; y < h; y++ {
+		b.WriteByte('#')
+		for x := 0; x < w; x++ {
+			c := byte('.')
+			if r.IntN(3) == 0 {
+				c = "^v<>"[r.IntN(4)]
+				if (x == 0 || x == w-1) && (c == '^' || c == 'v') {
+					c = '.'
+				}
+			}
+			b.WriteByte(c)
+		}
+		b.WriteString("#\n")
+	}
+	b.WriteString(strings.Repeat("#", w) + ".#\n")
+
+	// three crossings, with room to wait for the blizzards
+	return Input{Text: b.String(), Params: aoc.Params{"time": 6*(w+h) + 60}}
+}
@@ -0,0 +1,20 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// day25 generates size SNAFU numbers of up to 20 digits.
+func day25(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		b.WriteByte("12"[r.IntN(2)])
+		for n := r.IntN(20); n > 0; n-- {
+			b.WriteByte("=-012"[r.IntN(5)])
+		}
+		b.WriteString("\n")
+	}
+
+	return text(b.String())
+}
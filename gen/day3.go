@@ -0,0 +1,62 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+const items = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// day3 generates size groups of three rucksacks. Each rucksack has exactly one
+// item in both compartments, and each group exactly one item in all three.
+func day3(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		// the badge, then a separate pool of 17 items for each rucksack, so
+		// only the badge is common to all three
+		pool := []byte(items)
+		r.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+		badge, pool := pool[0], pool[1:]
+
+		for elf := 0; elf < 3; elf++ {
+			own := pool[elf*17 : (elf+1)*17]
+			b.WriteString(rucksack(r, badge, own))
+			b.WriteString("\n")
+		}
+	}
+
+	return text(b.String())
+}
+
+// rucksack returns a rucksack holding badge and items from own, with exactly
+// one item type in both compartments.
+func rucksack(r *rand.Rand, badge byte, own []byte) string {
+	// the shared item is first; the left compartment gets the next 8 items
+	// and the right the last 8
+	shared := own[0]
+	if r.IntN(4) == 0 {
+		shared = badge
+	}
+	left, right := own[1:9], own[9:17]
+
+	n := between(r, 2, 16)
+	l := []byte{shared}
+	rt := []byte{shared}
+	if shared != badge {
+		if r.IntN(2) == 0 {
+			l = append(l, badge)
+		} else {
+			rt = append(rt, badge)
+		}
+	}
+	for len(l) < n {
+		l = append(l, left[r.IntN(len(left))])
+	}
+	for len(rt) < n {
+		rt = append(rt, right[r.IntN(len(right))])
+	}
+	r.Shuffle(len(l), func(i, j int) { l[i], l[j] = l[j], l[i] })
+	r.Shuffle(len(rt), func(i, j int) { rt[i], rt[j] = rt[j], rt[i] })
+
+	return string(l) + string(rt)
+}
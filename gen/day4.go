@@ -0,0 +1,21 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day4 generates size pairs of section assignments.
+func day4(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		a1 := between(r, 1, 99)
+		a2 := between(r, a1, 99)
+		b1 := between(r, 1, 99)
+		b2 := between(r, b1, 99)
+		fmt.Fprintf(&b, "%d-%d,%d-%d\n", a1, a2, b1, b2)
+	}
+
+	return text(b.String())
+}
@@ -0,0 +1,56 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day5 generates up to nine stacks of crates and size moves between them.
+// Moves never empty a stack, so each has a crate on top at the end.
+func day5(r *rand.Rand, size int) Input {
+	stacks := make([][]byte, between(r, 3, 9))
+	height := 0
+	for i := range stacks {
+		for n := between(r, 1, 8); n > 0; n-- {
+			stacks[i] = append(stacks[i], byte('A'+r.IntN(26)))
+		}
+		height = max(height, len(stacks[i]))
+	}
+
+	var b strings.Builder
+	for y := height - 1; y >= 0; y-- {
+		row := make([]string, len(stacks))
+		for i, s := range stacks {
+			row[i] = "   "
+			if y < len(s) {
+				row[i] = "[" + string(s[y]) + "]"
+			}
+		}
+		b.WriteString(strings.Join(row, " ") + "\n")
+	}
+	for i := range stacks {
+		fmt.Fprintf(&b, " %d  ", i+1)
+	}
+	b.WriteString("\n\n")
+
+	for i := 0; i < size; i++ {
+		from := r.IntN(len(stacks))
+		for len(stacks[from]) < 2 {
+			from = r.IntN(len(stacks))
+		}
+		to := r.IntN(len(stacks) - 1)
+		if to >= from {
+			to++
+		}
+
+		n := between(r, 1, len(stacks[from])-1)
+		moved := stacks[from][len(stacks[from])-n:]
+		stacks[to] = append(stacks[to], moved...)
+		stacks[from] = stacks[from][:len(stacks[from])-n]
+
+		fmt.Fprintf(&b, "move %d from %d to %d\n", n, from+1, to+1)
+	}
+
+	return text(b.String())
+}
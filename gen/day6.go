@@ -0,0 +1,23 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// day6 generates a datastream of size characters, ending in the first run of
+// 14 different ones.
+func day6(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for i := 0; i < size-14; i++ {
+		// only four letters, so markers are rare until the end
+		b.WriteByte(byte('a' + r.IntN(4)))
+	}
+
+	end := []byte("abcdefghijklmnopqrstuvwxyz")
+	r.Shuffle(len(end), func(i, j int) { end[i], end[j] = end[j], end[i] })
+	b.Write(end[:14])
+	b.WriteString("\n")
+
+	return text(b.String())
+}
@@ -0,0 +1,63 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day7 generates a terminal transcript exploring a tree of size directories.
+// The disk is always between 45% and 95% full, so part 2 has a directory to
+// delete.
+func day7(r *rand.Rand, size int) Input {
+	type dir struct {
+		name     string
+		children []*dir
+		files    []int // relative sizes
+	}
+
+	root := &dir{name: "/"}
+	dirs := []*dir{root}
+	for i := 1; i < size; i++ {
+		parent := dirs[r.IntN(len(dirs))]
+		d := &dir{name: fmt.Sprintf("d%d", i)}
+		parent.children = append(parent.children, d)
+		dirs = append(dirs, d)
+	}
+
+	// spread the used space over the files, with a file or two per directory
+	used := between(r, 45, 95) * 70000000 / 100
+	total := 0
+	for _, d := range dirs {
+		n := between(r, 0, 2)
+		if d == root {
+			n = max(n, 1)
+		}
+		for ; n > 0; n-- {
+			s := between(r, 1, 1000)
+			d.files = append(d.files, s)
+			total += s
+		}
+	}
+
+	var b strings.Builder
+	var walk func(d *dir)
+	walk = func(d *dir) {
+		fmt.Fprintf(&b, "$ cd %s\n$ ls\n", d.name)
+		for _, c := range d.children {
+			fmt.Fprintf(&b, "dir %s\n", c.name)
+		}
+		for i, s := range d.files {
+			fmt.Fprintf(&b, "%d f%d.txt\n", max(1, s*used/total), i)
+		}
+		for _, c := range d.children {
+			walk(c)
+		}
+		if d != root {
+			b.WriteString("$ cd ..\n")
+		}
+	}
+	walk(root)
+
+	return text(b.String())
+}
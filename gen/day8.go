@@ -0,0 +1,19 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// day8 generates a size x size grid of tree heights.
+func day8(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			b.WriteByte(byte('0' + r.IntN(10)))
+		}
+		b.WriteString("\n")
+	}
+
+	return text(b.String())
+}
@@ -0,0 +1,17 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// day9 generates size moves of the rope's head.
+func day9(r *rand.Rand, size int) Input {
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		fmt.Fprintf(&b, "%c %d\n", "UDLR"[r.IntN(4)], between(r, 1, 20))
+	}
+
+	return text(b.String())
+}
@@ -0,0 +1,80 @@
+// Package gen generates random puzzle inputs for each day, of any size, for
+// finding performance cliffs that the two inputs we have hide, such as day 20's
+// mixing or day 7's directory sizes, and for cross-checking optimized solutions
+// against simpler reference ones.
+//
+// Generated inputs follow the puzzle's rules, so every day can solve them, but
+// don't always make the same promises as real inputs: a generated day 15 may
+// have no single spot for the distress beacon, and a generated day 24 valley
+// may be impassable.
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// Input is a generated puzzle input.
+type Input struct {
+	Text string
+
+	// Params are the parameters to solve Text with, over the puzzle's
+	// defaults, for days whose parameters depend on the input.
+	Params aoc.Params
+}
+
+// Generator generates an input of roughly size lines, items or cells, by
+// whatever measure suits the day, using r for randomness.
+type Generator func(r *rand.Rand, size int) Input
+
+var generators = map[int]Generator{
+	1: day1, 2: day2, 3: day3, 4: day4, 5: day5,
+	6: day6, 7: day7, 8: day8, 9: day9, 10: day10,
+	11: day11, 12: day12, 13: day13, 14: day14, 15: day15,
+	16: day16, 17: day17, 18: day18, 19: day19, 20: day20,
+	21: day21, 22: day22, 23: day23, 24: day24, 25: day25,
+}
+
+// For returns the generator for day.
+func For(day int) (Generator, bool) {
+	g, ok := generators[day]
+	return g, ok
+}
+
+// Days returns the days with generators, in order.
+func Days() []int {
+	days := make([]int, 0, len(generators))
+	for d := range generators {
+		days = append(days, d)
+	}
+	sort.Ints(days)
+
+	return days
+}
+
+// Generate generates an input for day of the given size. The same seed always
+// generates the same input.
+func Generate(day int, seed uint64, size int) (Input, error) {
+	g, ok := For(day)
+	if !ok {
+		return Input{}, fmt.Errorf("no generator for day %d", day)
+	}
+	if size < 1 {
+		return Input{}, fmt.Errorf("invalid size %d", size)
+	}
+
+	return g(rand.New(rand.NewPCG(seed, uint64(day))), size), nil
+}
+
+// between returns a random int in [lo, hi].
+func between(r *rand.Rand, lo, hi int) int {
+	return lo + r.IntN(hi-lo+1)
+}
+
+// text returns just the input text, with no parameters.
+func text(s string) Input {
+	return Input{Text: s}
+}
@@ -0,0 +1,114 @@
+package gen_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+	"github.com/nickshine/adventofcode2022/aoc/aoctest"
+	"github.com/nickshine/adventofcode2022/gen"
+
+	_ "github.com/nickshine/adventofcode2022/all"
+)
+
+// sizes are the sizes to test each day at, where the default of 20 won't do.
+var sizes = map[int]int{
+	19: 2, // a blueprint can take seconds
+}
+
+// TestSolve checks that every day solves generated inputs, the smallest as well
+// as bigger ones, within a second for each part. Parts that support
+// cancellation may give up instead, a generated day 15 may have no spot for
+// the distress beacon, and a generated day 24 valley may be impassable.
+func TestSolve(t *testing.T) {
+	for _, day := range gen.Days() {
+		p, ok := aoc.Lookup(day)
+		if !ok {
+			t.Fatalf("day %d is not registered", day)
+		}
+
+		size := sizes[day]
+		if size == 0 {
+			size = 20
+		}
+
+		for _, size := range []int{1, 2, size} {
+			for seed := uint64(1); seed <= 3; seed++ {
+				t.Run(fmt.Sprintf("day%d/size%d/seed%d", day, size, seed), func(t *testing.T) {
+					in, err := gen.Generate(day, seed, size)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					s, err := p.Load(strings.NewReader(in.Text), p.Params.Merge(in.Params))
+					if err != nil {
+						t.Fatalf("Parse: %v\n%s", err, in.Text)
+					}
+
+					for part := 1; part <= 2; part++ {
+						ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+						_, err := aoc.SolvePartContext(ctx, s, part)
+						cancel()
+
+						switch {
+						case err == nil, errors.Is(err, aoc.ErrNoPart), errors.Is(err, context.DeadlineExceeded):
+						case day == 15 && strings.Contains(err.Error(), "no distress beacon"):
+						case day == 24 && strings.Contains(err.Error(), "no path"):
+						default:
+							t.Errorf("part %d: %v", part, err)
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestGenerateSeed(t *testing.T) {
+	for _, day := range gen.Days() {
+		a, _ := gen.Generate(day, 1, 20)
+		b, _ := gen.Generate(day, 1, 20)
+		c, _ := gen.Generate(day, 2, 20)
+		if a.Text != b.Text {
+			t.Errorf("day %d: the same seed generated different inputs", day)
+		}
+		if a.Text == c.Text {
+			t.Errorf("day %d: different seeds generated the same input", day)
+		}
+	}
+}
+
+func TestGenerateError(t *testing.T) {
+	if _, err := gen.Generate(26, 1, 10); err == nil {
+		t.Errorf("Generate(26) succeeded")
+	}
+	if _, err := gen.Generate(1, 1, 0); err == nil {
+		t.Errorf("Generate with size 0 succeeded")
+	}
+}
+
+// BenchmarkScale measures the days whose inputs vary most in size, at sizes
+// from smaller to much bigger than the puzzle input, to show how they scale.
+func BenchmarkScale(b *testing.B) {
+	for _, day := range []int{7, 14, 20} {
+		p, _ := aoc.Lookup(day)
+		for _, size := range []int{100, 1000, 10000} {
+			in, err := gen.Generate(day, 1, size)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			for part := 1; part <= 2; part++ {
+				b.Run(fmt.Sprintf("day%d/size%d/part%d", day, size, part), func(b *testing.B) {
+					if err := aoctest.BenchmarkPart(b, p, in.Text, p.Params.Merge(in.Params), part); err != nil {
+						b.Fatal(err)
+					}
+				})
+			}
+		}
+	}
+}
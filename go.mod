@@ -0,0 +1,3 @@
+module github.com/nickshine/adventofcode2022
+
+go 1.22
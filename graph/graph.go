@@ -0,0 +1,333 @@
+// Package graph provides the searches shared by the puzzles that are graphs at
+// heart: height maps, valve tunnels, blizzard valleys and monkeys waiting on
+// each other. Graphs are implicit, described by a function from a node to its
+// neighbors, so a node can be anything comparable, such as a grid point or a
+// point and a time.
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Edge leads to To at a cost.
+type Edge[N comparable] struct {
+	To   N
+	Cost int
+}
+
+// Result is what a search found: the cost of reaching each node it reached,
+// and the goal, if it reached one.
+type Result[N comparable] struct {
+	Goal  N    // the first goal reached
+	Found bool // whether a goal was reached
+
+	reached map[N]reach[N]
+}
+
+// reach is how a node was reached.
+type reach[N comparable] struct {
+	dist  int
+	prev  N
+	start bool // the node is a start, and prev is unset
+}
+
+func newResult[N comparable]() *Result[N] {
+	return &Result[N]{reached: map[N]reach[N]{}}
+}
+
+// Dist returns the cost of the cheapest path found to n, and whether n was
+// reached.
+func (r *Result[N]) Dist(n N) (int, bool) {
+	v, ok := r.reached[n]
+	return v.dist, ok
+}
+
+// Path returns the path found from a start to n, both included, or nil if n
+// wasn't reached.
+func (r *Result[N]) Path(n N) []N {
+	v, ok := r.reached[n]
+	if !ok {
+		return nil
+	}
+
+	path := []N{n}
+	for !v.start {
+		path = append(path, v.prev)
+		v = r.reached[v.prev]
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+// BFS searches breadth first from starts, where every edge costs 1, and stops
+// at the first node that satisfies goal. A nil goal searches everything
+// reachable. The slice returned by neighbors is done with by the next call, so
+// it may be reused.
+func BFS[N comparable](starts []N, neighbors func(n N) []N, goal func(n N) bool) *Result[N] {
+	r := newResult[N]()
+	queue := make([]N, 0, len(starts))
+	for _, s := range starts {
+		if _, ok := r.reached[s]; !ok {
+			r.reached[s] = reach[N]{start: true}
+			queue = append(queue, s)
+		}
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		if goal != nil && goal(cur) {
+			r.Goal, r.Found = cur, true
+			return r
+		}
+
+		d := r.reached[cur].dist + 1
+		for _, next := range neighbors(cur) {
+			if _, ok := r.reached[next]; ok {
+				continue
+			}
+			r.reached[next] = reach[N]{dist: d, prev: cur}
+			queue = append(queue, next)
+		}
+	}
+
+	return r
+}
+
+// Dijkstra finds the cheapest paths from starts, and stops at the first node
+// that satisfies goal. Costs must not be negative. A nil goal searches
+// everything reachable.
+func Dijkstra[N comparable](starts []N, edges func(n N) []Edge[N], goal func(n N) bool) *Result[N] {
+	return AStar(starts, edges, goal, nil)
+}
+
+// AStar is Dijkstra guided by h, an estimate of the cost from a node to the
+// nearest goal. So that the path found is the cheapest, h must never
+// overestimate, nor drop by more than the cost of any edge. A nil h is
+// Dijkstra.
+func AStar[N comparable](starts []N, edges func(n N) []Edge[N], goal func(n N) bool, h func(n N) int) *Result[N] {
+	estimate := func(n N, d int) int {
+		if h == nil {
+			return d
+		}
+		return d + h(n)
+	}
+
+	r := newResult[N]()
+	var pq queue[N]
+	for _, s := range starts {
+		if _, ok := r.reached[s]; !ok {
+			r.reached[s] = reach[N]{start: true}
+			heap.Push(&pq, item[N]{s, 0, estimate(s, 0)})
+		}
+	}
+
+	done := map[N]bool{}
+	for pq.Len() > 0 {
+		it := heap.Pop(&pq).(item[N])
+		cur := it.node
+		if done[cur] || it.dist > r.reached[cur].dist { // a stale entry
+			continue
+		}
+		done[cur] = true
+
+		if goal != nil && goal(cur) {
+			r.Goal, r.Found = cur, true
+			return r
+		}
+
+		for _, e := range edges(cur) {
+			d := it.dist + e.Cost
+			if old, ok := r.reached[e.To]; ok && old.dist <= d {
+				continue
+			}
+			r.reached[e.To] = reach[N]{dist: d, prev: cur}
+			heap.Push(&pq, item[N]{e.To, d, estimate(e.To, d)})
+		}
+	}
+
+	return r
+}
+
+type item[N comparable] struct {
+	node     N
+	dist     int // cost from the start
+	priority int // dist plus the estimate to the goal
+}
+
+// queue is a min-heap of items by priority.
+type queue[N comparable] []item[N]
+
+func (q queue[N]) Len() int           { return len(q) }
+func (q queue[N]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q queue[N]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue[N]) Push(x any)        { *q = append(*q, x.(item[N])) }
+
+func (q *queue[N]) Pop() any {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
+
+// Distances holds the cost of the cheapest path between every pair of nodes,
+// as found by FloydWarshall.
+type Distances[N comparable] struct {
+	nodes []N
+	index map[N]int
+	dist  [][]int
+	next  [][]int // the node after i on the path from i to j, or -1
+}
+
+const unreachable = math.MaxInt / 2 // so that adding two doesn't overflow
+
+// FloydWarshall finds the cheapest paths between every pair of nodes. Edges
+// to nodes not in nodes are ignored.
+func FloydWarshall[N comparable](nodes []N, edges func(n N) []Edge[N]) *Distances[N] {
+	n := len(nodes)
+	d := &Distances[N]{
+		nodes: nodes,
+		index: make(map[N]int, n),
+		dist:  make([][]int, n),
+		next:  make([][]int, n),
+	}
+	for i, node := range nodes {
+		d.index[node] = i
+	}
+
+	for i := range nodes {
+		d.dist[i] = make([]int, n)
+		d.next[i] = make([]int, n)
+		for j := range nodes {
+			d.dist[i][j], d.next[i][j] = unreachable, -1
+		}
+		d.dist[i][i], d.next[i][i] = 0, i
+	}
+
+	for i, node := range nodes {
+		for _, e := range edges(node) {
+			if j, ok := d.index[e.To]; ok && e.Cost < d.dist[i][j] {
+				d.dist[i][j], d.next[i][j] = e.Cost, j
+			}
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			dik := d.dist[i][k]
+			if dik == unreachable {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if dist := dik + d.dist[k][j]; dist < d.dist[i][j] {
+					d.dist[i][j], d.next[i][j] = dist, d.next[i][k]
+				}
+			}
+		}
+	}
+
+	return d
+}
+
+// Dist returns the cost of the cheapest path from a to b, and whether there is
+// one.
+func (d *Distances[N]) Dist(a, b N) (int, bool) {
+	i, ok := d.index[a]
+	if !ok {
+		return 0, false
+	}
+	j, ok := d.index[b]
+	if !ok || d.dist[i][j] == unreachable {
+		return 0, false
+	}
+
+	return d.dist[i][j], true
+}
+
+// Path returns the cheapest path from a to b, both included, or nil if there
+// is none.
+func (d *Distances[N]) Path(a, b N) []N {
+	if _, ok := d.Dist(a, b); !ok {
+		return nil
+	}
+
+	i, j := d.index[a], d.index[b]
+	path := []N{a}
+	for i != j {
+		i = d.next[i][j]
+		path = append(path, d.nodes[i])
+	}
+
+	return path
+}
+
+// CycleError is returned by TopoSort when nodes depend on each other.
+type CycleError[N comparable] struct {
+	Cycle []N // each depends on the next, and the last on the first
+}
+
+func (e *CycleError[N]) Error() string {
+	names := make([]string, len(e.Cycle)+1)
+	for i, n := range e.Cycle {
+		names[i] = fmt.Sprint(n)
+	}
+	names[len(e.Cycle)] = fmt.Sprint(e.Cycle[0])
+
+	return "dependency cycle: " + strings.Join(names, " -> ")
+}
+
+// TopoSort orders nodes so that each comes after everything it depends on.
+// Dependencies that aren't in nodes are included too. Nodes that don't depend
+// on each other keep their order.
+func TopoSort[N comparable](nodes []N, deps func(n N) []N) ([]N, error) {
+	const (
+		visiting = 1
+		done     = 2
+	)
+
+	state := map[N]int{}
+	var order, stack []N
+
+	var visit func(n N) error
+	visit = func(n N) error {
+		switch state[n] {
+		case done:
+			return nil
+		case visiting:
+			for i := len(stack) - 1; i >= 0; i-- {
+				if stack[i] == n {
+					return &CycleError[N]{Cycle: append([]N(nil), stack[i:]...)}
+				}
+			}
+		}
+
+		state[n] = visiting
+		stack = append(stack, n)
+		for _, d := range deps(n) {
+			if err := visit(d); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[n] = done
+		order = append(order, n)
+
+		return nil
+	}
+
+	for _, n := range nodes {
+		if err := visit(n); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,143 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// weighted is a small directed graph:
+//
+//	a -1-> b -1-> c -1-> d
+//	a -5-> d
+//	e is on its own
+var weighted = map[string][]Edge[string]{
+	"a": {{"b", 1}, {"d", 5}},
+	"b": {{"c", 1}},
+	"c": {{"d", 1}},
+}
+
+func edges(n string) []Edge[string] {
+	return weighted[n]
+}
+
+func neighbors(n string) []string {
+	var out []string
+	for _, e := range weighted[n] {
+		out = append(out, e.To)
+	}
+	return out
+}
+
+func is(goal string) func(string) bool {
+	return func(n string) bool { return n == goal }
+}
+
+func TestBFS(t *testing.T) {
+	r := BFS([]string{"a"}, neighbors, is("d"))
+	if !r.Found || r.Goal != "d" {
+		t.Fatalf("BFS found %v, %q, want true, \"d\"", r.Found, r.Goal)
+	}
+	if d, _ := r.Dist("d"); d != 1 {
+		t.Errorf("Dist(d) = %d, want 1", d)
+	}
+	if got, want := r.Path("d"), []string{"a", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(d) = %v, want %v", got, want)
+	}
+
+	r = BFS([]string{"a"}, neighbors, is("e"))
+	if r.Found {
+		t.Errorf("BFS found the unreachable e")
+	}
+	if d, ok := r.Dist("c"); !ok || d != 2 {
+		t.Errorf("Dist(c) = %d, %v, want 2, true", d, ok)
+	}
+	if r.Path("e") != nil {
+		t.Errorf("Path(e) = %v, want nil", r.Path("e"))
+	}
+}
+
+func TestBFSStarts(t *testing.T) {
+	r := BFS([]string{"a", "c"}, neighbors, is("d"))
+	if got, want := r.Path("d"), []string{"a", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(d) = %v, want %v", got, want)
+	}
+	if d, _ := r.Dist("c"); d != 0 {
+		t.Errorf("Dist to a start = %d, want 0", d)
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	r := Dijkstra([]string{"a"}, edges, is("d"))
+	if d, _ := r.Dist("d"); !r.Found || d != 3 {
+		t.Errorf("Dist(d) = %d, want 3", d)
+	}
+	if got, want := r.Path("d"), []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(d) = %v, want %v", got, want)
+	}
+}
+
+func TestAStar(t *testing.T) {
+	// a line of 100 nodes, with a shortcut from 10 to 90
+	line := func(n int) []Edge[int] {
+		out := []Edge[int]{{n + 1, 1}}
+		if n == 10 {
+			out = append(out, Edge[int]{90, 1})
+		}
+		return out
+	}
+	h := func(n int) int {
+		if n <= 10 {
+			return 11 - n
+		}
+		return 99 - n
+	}
+
+	r := AStar([]int{0}, line, func(n int) bool { return n == 99 }, h)
+	if d, _ := r.Dist(99); d != 20 {
+		t.Errorf("Dist(99) = %d, want 20", d)
+	}
+	if _, ok := r.Dist(50); ok {
+		t.Errorf("A* explored 50, off the shortest path")
+	}
+}
+
+func TestFloydWarshall(t *testing.T) {
+	d := FloydWarshall([]string{"a", "b", "c", "d", "e"}, edges)
+	if got, ok := d.Dist("a", "d"); !ok || got != 3 {
+		t.Errorf("Dist(a, d) = %d, %v, want 3, true", got, ok)
+	}
+	if _, ok := d.Dist("d", "a"); ok {
+		t.Errorf("Dist(d, a) ok, want no path")
+	}
+	if got, want := d.Path("a", "d"), []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(a, d) = %v, want %v", got, want)
+	}
+	if got, want := d.Path("e", "e"), []string{"e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(e, e) = %v, want %v", got, want)
+	}
+}
+
+func TestTopoSort(t *testing.T) {
+	deps := map[string][]string{
+		"root": {"x", "y"},
+		"x":    {"y", "z"},
+	}
+	got, err := TopoSort([]string{"root"}, func(n string) []string { return deps[n] })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"y", "z", "x", "root"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TopoSort = %v, want %v", got, want)
+	}
+
+	deps["z"] = []string{"root"}
+	_, err = TopoSort([]string{"root"}, func(n string) []string { return deps[n] })
+	var cycle *CycleError[string]
+	if !errors.As(err, &cycle) {
+		t.Fatalf("TopoSort error = %v, want a CycleError", err)
+	}
+	if want := []string{"root", "x", "z"}; !reflect.DeepEqual(cycle.Cycle, want) {
+		t.Errorf("Cycle = %v, want %v", cycle.Cycle, want)
+	}
+}
@@ -0,0 +1,418 @@
+// Package grid provides a two dimensional grid for the puzzles played out on
+// maps: tree heights, height maps, caves, chambers, boards and valleys.
+package grid
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// Point is a position on a grid. X grows to the right and Y grows down, so the
+// first line of a parsed grid is Y 0.
+type Point struct {
+	X, Y int
+}
+
+// Add returns p moved by d.
+func (p Point) Add(d Point) Point {
+	return Point{p.X + d.X, p.Y + d.Y}
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("%d,%d", p.X, p.Y)
+}
+
+// The four orthogonal directions, as deltas.
+var (
+	Up    = Point{0, -1}
+	Right = Point{1, 0}
+	Down  = Point{0, 1}
+	Left  = Point{-1, 0}
+)
+
+// Neighbors4 are the orthogonal directions, clockwise from Up.
+var Neighbors4 = []Point{Up, Right, Down, Left}
+
+// Neighbors8 are the orthogonal and diagonal directions, clockwise from Up.
+var Neighbors8 = []Point{
+	Up, {1, -1}, Right, {1, 1}, Down, {-1, 1}, Left, {-1, -1},
+}
+
+// Wrap controls what happens to points that fall off an edge of a bounded grid.
+type Wrap int
+
+const (
+	NoWrap   Wrap = iota // points off the grid are out of bounds
+	WrapX                // points off the left or right edge come back on the other side
+	WrapY                // points off the top or bottom edge come back on the other side
+	WrapBoth             // the grid is a torus
+)
+
+// Grid is a two dimensional grid of T. A dense grid stores every cell, and
+// suits small maps that are mostly full. A sparse grid stores only the cells
+// that are set, and may be unbounded, which suits things that spread, such as
+// elves.
+//
+// Cells that haven't been set read as the zero value of T.
+type Grid[T any] struct {
+	width, height int
+	wrap          Wrap
+
+	dense  []T       // row major, when dense
+	sparse map[key]T // when sparse
+}
+
+// key packs a point into a single word, which makes for much faster map
+// lookups than the Point itself.
+type key uint64
+
+func keyOf(p Point) key {
+	return key(uint32(p.X))<<32 | key(uint32(p.Y))
+}
+
+func (k key) point() Point {
+	return Point{int(int32(k >> 32)), int(int32(k))}
+}
+
+func fitsKey(p Point) bool {
+	return p.X == int(int32(p.X)) && p.Y == int(int32(p.Y))
+}
+
+// New returns a dense width x height grid.
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{width: width, height: height, dense: make([]T, width*height)}
+}
+
+// NewSparse returns a sparse width x height grid. A sparse grid with zero
+// width and height is unbounded: every point is in it, and Bounds reports the
+// extent of the cells set. Its points must fit in an int32.
+func NewSparse[T any](width, height int) *Grid[T] {
+	return &Grid[T]{width: width, height: height, sparse: map[key]T{}}
+}
+
+// Parse returns a dense grid from the lines of in, one cell per character.
+// Every line must be the same width. cell converts each character, and its
+// error is returned as an *aoc.ParseError pointing at the character.
+func Parse[T any](in string, cell func(r rune) (T, error)) (*Grid[T], error) {
+	return ParseLines(aoc.Lines(in), cell)
+}
+
+// ParseLines is like Parse, for input already split into lines.
+func ParseLines[T any](lines []aoc.Line, cell func(r rune) (T, error)) (*Grid[T], error) {
+	if len(lines) == 0 {
+		return nil, aoc.EmptyError()
+	}
+
+	for _, l := range lines {
+		if len(l.Text) != len(lines[0].Text) {
+			return nil, l.Errorf(l.Text, "row is %d wide, want %d", len(l.Text), len(lines[0].Text))
+		}
+	}
+
+	return ParseRagged(lines, cell, *new(T))
+}
+
+// ParseRagged is like ParseLines but allows lines of any width. The grid is as
+// wide as the widest line, and short lines are padded with fill.
+func ParseRagged[T any](lines []aoc.Line, cell func(r rune) (T, error), fill T) (*Grid[T], error) {
+	if len(lines) == 0 {
+		return nil, aoc.EmptyError()
+	}
+
+	width := 0
+	for _, l := range lines {
+		if len(l.Text) > width {
+			width = len(l.Text)
+		}
+	}
+
+	g := New[T](width, len(lines))
+	for y, l := range lines {
+		for x := len(l.Text); x < width; x++ {
+			g.Set(Point{x, y}, fill)
+		}
+		for x, r := range []byte(l.Text) {
+			v, err := cell(rune(r))
+			if err != nil {
+				return nil, l.ErrorAt(x, string(r), err)
+			}
+			g.Set(Point{x, y}, v)
+		}
+	}
+
+	return g, nil
+}
+
+// SetWrap sets how points off the edge of g are treated by In, Get, At, Set
+// and Neighbors. Wrapping has no effect on an unbounded grid.
+func (g *Grid[T]) SetWrap(w Wrap) {
+	g.wrap = w
+}
+
+// Width returns the width of g, or 0 if it is unbounded.
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+// Height returns the height of g, or 0 if it is unbounded.
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+func (g *Grid[T]) unbounded() bool {
+	return g.sparse != nil && g.width == 0 && g.height == 0
+}
+
+// Bounds returns the top left and bottom right points of g, inclusive. For an
+// unbounded grid they surround the cells that are set, and ok is false if
+// there are none.
+func (g *Grid[T]) Bounds() (min, max Point, ok bool) {
+	if !g.unbounded() {
+		return Point{}, Point{g.width - 1, g.height - 1}, g.width > 0 && g.height > 0
+	}
+
+	first := true
+	for k := range g.sparse {
+		p := k.point()
+		if first {
+			min, max, first = p, p, false
+			continue
+		}
+		min.X, min.Y = minInt(min.X, p.X), minInt(min.Y, p.Y)
+		max.X, max.Y = maxInt(max.X, p.X), maxInt(max.Y, p.Y)
+	}
+
+	return min, max, !first
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func mod(a, n int) int {
+	a %= n
+	if a < 0 {
+		a += n
+	}
+	return a
+}
+
+// resolve applies g's wrapping to p, and reports whether the result is in g.
+func (g *Grid[T]) resolve(p Point) (Point, bool) {
+	if g.wrap == NoWrap && g.sparse == nil { // the common case, kept cheap
+		return p, uint(p.X) < uint(g.width) && uint(p.Y) < uint(g.height)
+	}
+	if g.unbounded() {
+		return p, fitsKey(p)
+	}
+	if g.width <= 0 || g.height <= 0 {
+		return p, false
+	}
+
+	if g.wrap == WrapX || g.wrap == WrapBoth {
+		p.X = mod(p.X, g.width)
+	}
+	if g.wrap == WrapY || g.wrap == WrapBoth {
+		p.Y = mod(p.Y, g.height)
+	}
+
+	return p, p.X >= 0 && p.X < g.width && p.Y >= 0 && p.Y < g.height
+}
+
+// Wrapped returns p with g's wrapping applied.
+func (g *Grid[T]) Wrapped(p Point) Point {
+	p, _ = g.resolve(p)
+	return p
+}
+
+// In reports whether p, after wrapping, is in g.
+func (g *Grid[T]) In(p Point) bool {
+	_, ok := g.resolve(p)
+	return ok
+}
+
+// Get returns the value at p, and whether p is in g.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	p, ok := g.resolve(p)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	if g.sparse != nil {
+		return g.sparse[keyOf(p)], true
+	}
+
+	return g.dense[p.Y*g.width+p.X], true
+}
+
+// At returns the value at p, or the zero value if p is not in g.
+func (g *Grid[T]) At(p Point) T {
+	v, _ := g.Get(p)
+	return v
+}
+
+// Set sets the value at p, and reports whether p is in g. Setting a point
+// outside g does nothing.
+func (g *Grid[T]) Set(p Point, v T) bool {
+	p, ok := g.resolve(p)
+	if !ok {
+		return false
+	}
+
+	if g.sparse != nil {
+		g.sparse[keyOf(p)] = v
+	} else {
+		g.dense[p.Y*g.width+p.X] = v
+	}
+
+	return true
+}
+
+// Delete clears the cell at p back to the zero value. In a sparse grid the
+// cell is no longer stored.
+func (g *Grid[T]) Delete(p Point) {
+	p, ok := g.resolve(p)
+	if !ok {
+		return
+	}
+
+	if g.sparse != nil {
+		delete(g.sparse, keyOf(p))
+		return
+	}
+
+	var zero T
+	g.dense[p.Y*g.width+p.X] = zero
+}
+
+// Len returns the number of cells stored: every cell of a dense grid, or the
+// cells set in a sparse one.
+func (g *Grid[T]) Len() int {
+	if g.sparse != nil {
+		return len(g.sparse)
+	}
+
+	return len(g.dense)
+}
+
+// Neighbors returns the points one step from p in each of dirs, such as
+// Neighbors4 or Neighbors8, that are in g, after wrapping.
+func (g *Grid[T]) Neighbors(p Point, dirs []Point) []Point {
+	out := make([]Point, 0, len(dirs))
+	for _, d := range dirs {
+		if n, ok := g.resolve(p.Add(d)); ok {
+			out = append(out, n)
+		}
+	}
+
+	return out
+}
+
+// Each calls fn for every stored cell, in reading order: every cell of a dense
+// grid, or the cells set in a sparse one.
+func (g *Grid[T]) Each(fn func(p Point, v T)) {
+	if g.sparse == nil {
+		for i, v := range g.dense {
+			fn(Point{i % g.width, i / g.width}, v)
+		}
+		return
+	}
+
+	type cell struct {
+		p Point
+		v T
+	}
+	cells := make([]cell, 0, len(g.sparse))
+	for k, v := range g.sparse {
+		cells = append(cells, cell{k.point(), v})
+	}
+	slices.SortFunc(cells, func(a, b cell) int {
+		if a.p.Y != b.p.Y {
+			return a.p.Y - b.p.Y
+		}
+		return a.p.X - b.p.X
+	})
+
+	for _, c := range cells {
+		fn(c.p, c.v)
+	}
+}
+
+// Range calls fn for every stored cell, like Each but in no particular order,
+// which saves sorting the cells of a sparse grid.
+func (g *Grid[T]) Range(fn func(p Point, v T)) {
+	if g.sparse == nil {
+		g.Each(fn)
+		return
+	}
+
+	for k, v := range g.sparse {
+		fn(k.point(), v)
+	}
+}
+
+// Find returns the first point in reading order whose value satisfies match.
+func (g *Grid[T]) Find(match func(v T) bool) (Point, bool) {
+	var found Point
+	ok := false
+	g.Each(func(p Point, v T) {
+		if !ok && match(v) {
+			found, ok = p, true
+		}
+	})
+
+	return found, ok
+}
+
+// Clone returns a copy of g. The values themselves are copied as by
+// assignment.
+func (g *Grid[T]) Clone() *Grid[T] {
+	c := *g
+	if g.sparse != nil {
+		c.sparse = make(map[key]T, len(g.sparse))
+		for k, v := range g.sparse {
+			c.sparse[k] = v
+		}
+	} else {
+		c.dense = append([]T(nil), g.dense...)
+	}
+
+	return &c
+}
+
+// Render writes g to w one row per line, using cell to draw each point. An
+// unbounded grid is drawn over the bounds of its set cells.
+func (g *Grid[T]) Render(w io.Writer, cell func(p Point, v T) rune) error {
+	min, max, ok := g.Bounds()
+	if !ok {
+		return nil
+	}
+
+	row := make([]rune, 0, max.X-min.X+1)
+	for y := min.Y; y <= max.Y; y++ {
+		row = row[:0]
+		for x := min.X; x <= max.X; x++ {
+			p := Point{x, y}
+			row = append(row, cell(p, g.At(p)))
+		}
+		if _, err := fmt.Fprintln(w, string(row)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package grid
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+func digit(r rune) (int, error) {
+	if r < '0' || r > '9' {
+		return 0, errors.New("expected a digit")
+	}
+	return int(r - '0'), nil
+}
+
+func TestParse(t *testing.T) {
+	g, err := Parse("123\n456\n", digit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Errorf("size = %dx%d, want 3x2", g.Width(), g.Height())
+	}
+	if got := g.At(Point{2, 1}); got != 6 {
+		t.Errorf("At(2,1) = %d, want 6", got)
+	}
+	if v, ok := g.Get(Point{3, 0}); ok || v != 0 {
+		t.Errorf("Get(3,0) = %d, %v, want 0, false", v, ok)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, c := range []struct {
+		in           string
+		line, column int
+	}{
+		{"", 1, 0},
+		{"12\n3x\n", 2, 2},
+		{"12\n345\n", 2, 1},
+	} {
+		_, err := Parse(c.in, digit)
+		var perr *aoc.ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("Parse(%q) error = %v, want a ParseError", c.in, err)
+			continue
+		}
+		if perr.Line != c.line || perr.Column != c.column {
+			t.Errorf("Parse(%q) error at line %d, column %d, want line %d, column %d", c.in, perr.Line, perr.Column, c.line, c.column)
+		}
+	}
+}
+
+func TestParseRagged(t *testing.T) {
+	g, err := ParseRagged(aoc.Lines("  .#\n.\n"), func(r rune) (rune, error) { return r, nil }, ' ')
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	g.Render(&b, func(_ Point, v rune) rune { return v })
+	if got, want := b.String(), "  .#\n.   \n"; got != want {
+		t.Errorf("Render = %q, want %q", got, want)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	g := New[int](3, 2)
+	for _, c := range []struct {
+		wrap Wrap
+		p    Point
+		want Point
+		in   bool
+	}{
+		{NoWrap, Point{-1, 0}, Point{-1, 0}, false},
+		{WrapX, Point{-1, 0}, Point{2, 0}, true},
+		{WrapX, Point{0, 2}, Point{0, 2}, false},
+		{WrapY, Point{0, 2}, Point{0, 0}, true},
+		{WrapBoth, Point{4, -3}, Point{1, 1}, true},
+	} {
+		g.SetWrap(c.wrap)
+		if got := g.Wrapped(c.p); got != c.want {
+			t.Errorf("wrap %d: Wrapped(%v) = %v, want %v", c.wrap, c.p, got, c.want)
+		}
+		if got := g.In(c.p); got != c.in {
+			t.Errorf("wrap %d: In(%v) = %v, want %v", c.wrap, c.p, got, c.in)
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := New[int](3, 3)
+	if got, want := g.Neighbors(Point{0, 0}, Neighbors4), []Point{{1, 0}, {0, 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbors4 of a corner = %v, want %v", got, want)
+	}
+	if got := g.Neighbors(Point{1, 1}, Neighbors8); len(got) != 8 {
+		t.Errorf("Neighbors8 of the center = %v, want 8 points", got)
+	}
+
+	g.SetWrap(WrapBoth)
+	if got, want := g.Neighbors(Point{0, 0}, Neighbors4), []Point{{0, 2}, {1, 0}, {0, 1}, {2, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wrapped Neighbors4 of a corner = %v, want %v", got, want)
+	}
+}
+
+func TestSparse(t *testing.T) {
+	g := NewSparse[bool](0, 0)
+	if _, _, ok := g.Bounds(); ok {
+		t.Errorf("Bounds of an empty grid reported ok")
+	}
+
+	g.Set(Point{-2, 5}, true)
+	g.Set(Point{3, -1}, true)
+	g.Set(Point{0, 0}, true)
+	g.Delete(Point{0, 0})
+
+	min, max, ok := g.Bounds()
+	if !ok || min != (Point{-2, -1}) || max != (Point{3, 5}) {
+		t.Errorf("Bounds = %v, %v, %v, want -2,-1, 3,5, true", min, max, ok)
+	}
+	if g.Len() != 2 {
+		t.Errorf("Len = %d, want 2", g.Len())
+	}
+
+	var order []Point
+	g.Each(func(p Point, _ bool) { order = append(order, p) })
+	if want := []Point{{3, -1}, {-2, 5}}; !reflect.DeepEqual(order, want) {
+		t.Errorf("Each order = %v, want %v", order, want)
+	}
+
+	seen := map[Point]bool{}
+	g.Range(func(p Point, _ bool) { seen[p] = true })
+	if len(seen) != 2 || !seen[Point{3, -1}] || !seen[Point{-2, 5}] {
+		t.Errorf("Range saw %v, want 3,-1 and -2,5", seen)
+	}
+}
+
+func TestClone(t *testing.T) {
+	for _, g := range []*Grid[int]{New[int](2, 2), NewSparse[int](2, 2)} {
+		g.Set(Point{1, 1}, 1)
+		c := g.Clone()
+		c.Set(Point{1, 1}, 2)
+		if g.At(Point{1, 1}) != 1 {
+			t.Errorf("setting a clone changed the original")
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package leaderboard
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// StarTime is when a member got a star.
+type StarTime struct {
+	Day, Part int
+	At        time.Time
+	Elapsed   time.Duration // since the puzzle unlocked
+}
+
+// Timeline returns every star m got, in the order they got them.
+func (lb *Leaderboard) Timeline(m *Member) []StarTime {
+	var stars []StarTime
+	for day, parts := range m.Completion {
+		for part, s := range parts {
+			at := s.At.Time()
+			stars = append(stars, StarTime{Day: day, Part: part, At: at, Elapsed: at.Sub(Unlock(lb.year, day))})
+		}
+	}
+
+	slices.SortFunc(stars, func(a, b StarTime) int {
+		return cmp.Or(a.At.Compare(b.At), cmp.Compare(a.Day, b.Day), cmp.Compare(a.Part, b.Part))
+	})
+	return stars
+}
+
+// Delta is how long a member took over each part of a day.
+type Delta struct {
+	Day          int
+	Part1, Part2 time.Duration // since the puzzle unlocked
+}
+
+// Between returns how long part 2 took after part 1.
+func (d Delta) Between() time.Duration {
+	return d.Part2 - d.Part1
+}
+
+// Deltas returns the times of the days m got both stars for, in day order.
+func (lb *Leaderboard) Deltas(m *Member) []Delta {
+	var deltas []Delta
+	for day := 1; day <= 25; day++ {
+		p1, ok1 := m.Completion[day][1]
+		p2, ok2 := m.Completion[day][2]
+		if !ok1 || !ok2 {
+			continue
+		}
+		unlock := Unlock(lb.year, day)
+		deltas = append(deltas, Delta{Day: day, Part1: p1.At.Time().Sub(unlock), Part2: p2.At.Time().Sub(unlock)})
+	}
+	return deltas
+}
+
+// Scores returns the local score of each member by ID, scored as the site
+// does: for each star, the first member to get it gets a point for every
+// member of the leaderboard, the second one less, and so on.
+func (lb *Leaderboard) Scores() map[ID]int {
+	type got struct {
+		id   ID
+		star Star
+	}
+
+	scores := map[ID]int{}
+	for _, m := range lb.Members {
+		scores[m.ID] = 0
+	}
+
+	for day := 1; day <= 25; day++ {
+		for part := 1; part <= 2; part++ {
+			var order []got
+			for _, m := range lb.Members {
+				if s, ok := m.Completion[day][part]; ok {
+					order = append(order, got{m.ID, s})
+				}
+			}
+			slices.SortFunc(order, func(a, b got) int {
+				return cmp.Or(cmp.Compare(a.star.At, b.star.At), cmp.Compare(a.star.Index, b.star.Index))
+			})
+
+			for i, g := range order {
+				scores[g.id] += len(lb.Members) - i
+			}
+		}
+	}
+
+	return scores
+}
+
+// Streak is a member's runs of consecutive days solved, both parts, within a
+// day of the puzzle unlocking.
+type Streak struct {
+	Current int // the run up to now; a day still within its day doesn't end it
+	Longest int
+}
+
+// Streak returns m's streaks as of now.
+func (lb *Leaderboard) Streak(m *Member, now time.Time) Streak {
+	var s Streak
+	run := 0
+	for day := 1; day <= 25; day++ {
+		unlock := Unlock(lb.year, day)
+		if now.Before(unlock) {
+			break
+		}
+
+		deadline := unlock.Add(24 * time.Hour)
+		p2, ok := m.Completion[day][2]
+		switch {
+		case ok && p2.At.Time().Before(deadline):
+			run++
+		case now.Before(deadline):
+			// today's puzzle, which there's still time for
+		default:
+			run = 0
+		}
+		s.Longest = max(s.Longest, run)
+	}
+
+	s.Current = run
+	return s
+}
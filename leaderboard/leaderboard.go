@@ -0,0 +1,149 @@
+// Package leaderboard analyzes an Advent of Code private leaderboard from the
+// JSON the site exports for it: when each member got each star, how long part
+// 2 took them after part 1, the local scores, and streaks of days solved on
+// the day they unlocked.
+package leaderboard
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Leaderboard is a private leaderboard as the site exports it.
+type Leaderboard struct {
+	Event   string             `json:"event"` // the year
+	OwnerID ID                 `json:"owner_id"`
+	Members map[string]*Member `json:"members"` // by ID
+
+	year int
+}
+
+// Member is a member of a leaderboard and the stars they've got.
+type Member struct {
+	ID          ID        `json:"id"`
+	Name        string    `json:"name"` // empty for anonymous users
+	Stars       int       `json:"stars"`
+	LocalScore  int       `json:"local_score"` // as the site scored it
+	GlobalScore int       `json:"global_score"`
+	LastStar    Timestamp `json:"last_star_ts"`
+
+	// Completion holds the stars got, by day then part.
+	Completion map[int]map[int]Star `json:"completion_day_level"`
+}
+
+// Star is when a member got a star.
+type Star struct {
+	At    Timestamp `json:"get_star_ts"`
+	Index int64     `json:"star_index"` // orders stars got in the same second
+}
+
+// ID identifies a user. The site exports IDs and timestamps as numbers, but
+// did as strings before 2020, so both read either.
+type ID int
+
+func (id *ID) UnmarshalJSON(b []byte) error {
+	n, err := strconv.Atoi(string(bytes.Trim(b, `"`)))
+	if err != nil {
+		return fmt.Errorf("invalid ID %s", b)
+	}
+	*id = ID(n)
+	return nil
+}
+
+// Timestamp is a Unix time in seconds.
+type Timestamp int64
+
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	n, err := strconv.ParseInt(string(bytes.Trim(b, `"`)), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %s", b)
+	}
+	*t = Timestamp(n)
+	return nil
+}
+
+// Time returns t as a time.
+func (t Timestamp) Time() time.Time {
+	return time.Unix(int64(t), 0).UTC()
+}
+
+// Parse parses a leaderboard's JSON.
+func Parse(data []byte) (*Leaderboard, error) {
+	var lb Leaderboard
+	if err := json.Unmarshal(data, &lb); err != nil {
+		return nil, fmt.Errorf("invalid leaderboard: %w", err)
+	}
+
+	year, err := strconv.Atoi(lb.Event)
+	if err != nil {
+		return nil, fmt.Errorf("invalid leaderboard: event %q isn't a year", lb.Event)
+	}
+	lb.year = year
+
+	return &lb, nil
+}
+
+// ReadFile parses the leaderboard JSON in the file at path.
+func ReadFile(path string) (*Leaderboard, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lb, err := Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return lb, nil
+}
+
+// Year returns the year of the leaderboard's event.
+func (lb *Leaderboard) Year() int {
+	return lb.year
+}
+
+// puzzleTime is the time zone puzzles unlock at midnight in.
+var puzzleTime = time.FixedZone("EST", -5*60*60)
+
+// Unlock returns when the puzzle for day of year unlocked.
+func Unlock(year, day int) time.Time {
+	return time.Date(year, time.December, day, 0, 0, 0, 0, puzzleTime)
+}
+
+// DisplayName returns m's name, or the site's stand-in for an anonymous user.
+func (m *Member) DisplayName() string {
+	if m.Name == "" {
+		return fmt.Sprintf("(anonymous user #%d)", m.ID)
+	}
+	return m.Name
+}
+
+// List returns the members in order of ID.
+func (lb *Leaderboard) List() []*Member {
+	members := make([]*Member, 0, len(lb.Members))
+	for _, m := range lb.Members {
+		members = append(members, m)
+	}
+	slices.SortFunc(members, func(a, b *Member) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return members
+}
+
+// Find returns the members with name, ignoring case, or with it as their ID.
+func (lb *Leaderboard) Find(name string) []*Member {
+	var found []*Member
+	for _, m := range lb.List() {
+		if strings.EqualFold(m.Name, name) || strconv.Itoa(int(m.ID)) == name {
+			found = append(found, m)
+		}
+	}
+	return found
+}
@@ -0,0 +1,148 @@
+package leaderboard
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func read(t *testing.T, path string) *Leaderboard {
+	t.Helper()
+	lb, err := ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lb
+}
+
+func member(t *testing.T, lb *Leaderboard, name string) *Member {
+	t.Helper()
+	found := lb.Find(name)
+	if len(found) != 1 {
+		t.Fatalf("Find(%q) = %d members, want 1", name, len(found))
+	}
+	return found[0]
+}
+
+func TestParse(t *testing.T) {
+	lb := read(t, "testdata/2022.json")
+	if lb.Year() != 2022 || lb.OwnerID != 101 || len(lb.Members) != 4 {
+		t.Errorf("got year %d, owner %d and %d members, want 2022, 101 and 4", lb.Year(), lb.OwnerID, len(lb.Members))
+	}
+	if got := member(t, lb, "103").DisplayName(); got != "(anonymous user #103)" {
+		t.Errorf("anonymous DisplayName = %q", got)
+	}
+
+	// before 2020, numbers were exported as strings
+	old := read(t, "testdata/2019.json")
+	dave := member(t, old, "dave")
+	if dave.ID != 7 || dave.LastStar != 1575176520 || len(old.Timeline(dave)) != 2 {
+		t.Errorf("2019 member = %+v", dave)
+	}
+
+	for _, bad := range []string{`{"event": "next year"}`, `{"event": "2022", "members": {"1": {"id": "one"}}}`, `[`} {
+		if _, err := Parse([]byte(bad)); err == nil {
+			t.Errorf("Parse(%s) succeeded", bad)
+		}
+	}
+}
+
+func TestUnlock(t *testing.T) {
+	if got, want := Unlock(2022, 1).Unix(), int64(1669870800); got != want {
+		t.Errorf("Unlock(2022, 1) = %d, want %d", got, want)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	lb := read(t, "testdata/2022.json")
+	stars := lb.Timeline(member(t, lb, "Bob"))
+	if len(stars) != 5 {
+		t.Fatalf("got %d stars, want 5", len(stars))
+	}
+	for i, want := range []StarTime{{Day: 1, Part: 1, Elapsed: 400 * time.Second}, {Day: 1, Part: 2}, {Day: 2, Part: 1}, {Day: 2, Part: 2}, {Day: 3, Part: 1, Elapsed: time.Hour}} {
+		s := stars[i]
+		if s.Day != want.Day || s.Part != want.Part || want.Elapsed != 0 && s.Elapsed != want.Elapsed {
+			t.Errorf("star %d = day %d part %d after %s, want day %d part %d", i, s.Day, s.Part, s.Elapsed, want.Day, want.Part)
+		}
+	}
+}
+
+func TestDeltas(t *testing.T) {
+	lb := read(t, "testdata/2022.json")
+	deltas := lb.Deltas(member(t, lb, "Alice"))
+	want := []time.Duration{5 * time.Minute, 800 * time.Second, 89900 * time.Second}
+	if len(deltas) != len(want) {
+		t.Fatalf("got %d deltas, want %d", len(deltas), len(want))
+	}
+	for i, d := range deltas {
+		if d.Day != i+1 || d.Between() != want[i] {
+			t.Errorf("delta %d = day %d, %s, want day %d, %s", i, d.Day, d.Between(), i+1, want[i])
+		}
+	}
+}
+
+func TestScores(t *testing.T) {
+	lb := read(t, "testdata/2022.json")
+	scores := lb.Scores()
+	for _, m := range lb.Members {
+		if scores[m.ID] != m.LocalScore {
+			t.Errorf("%s scored %d, the site says %d", m.DisplayName(), scores[m.ID], m.LocalScore)
+		}
+	}
+}
+
+func TestStreak(t *testing.T) {
+	lb := read(t, "testdata/2022.json")
+	for _, tc := range []struct {
+		name string
+		now  time.Time
+		want Streak
+	}{
+		// day 3 is unlocked but there's still time for it
+		{"Alice", time.Date(2022, 12, 4, 0, 0, 0, 0, time.UTC), Streak{Current: 2, Longest: 2}},
+		{"Bob", time.Date(2022, 12, 4, 0, 0, 0, 0, time.UTC), Streak{Current: 2, Longest: 2}},
+		// neither finished day 3 in time
+		{"Alice", time.Date(2022, 12, 5, 0, 0, 0, 0, time.UTC), Streak{Current: 0, Longest: 2}},
+		{"Bob", time.Date(2022, 12, 5, 0, 0, 0, 0, time.UTC), Streak{Current: 0, Longest: 2}},
+		{"103", time.Date(2022, 12, 5, 0, 0, 0, 0, time.UTC), Streak{}},
+	} {
+		if got := lb.Streak(member(t, lb, tc.name), tc.now); got != tc.want {
+			t.Errorf("%s's streak at %s = %+v, want %+v", tc.name, tc.now, got, tc.want)
+		}
+	}
+}
+
+func TestScoreTable(t *testing.T) {
+	lb := read(t, "testdata/2022.json")
+	var b bytes.Buffer
+	if err := lb.ScoreTable(time.Date(2022, 12, 4, 0, 0, 0, 0, time.UTC)).WriteCSV(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `RANK,NAME,SCORE,STARS,STREAK,LONGEST STREAK
+1,Alice,22,6,2,2
+2,Bob,17,5,2,2
+3,(anonymous user #103),4,2,0,0
+4,Carol,0,0,0,0
+`
+	if b.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+func TestDeltaTable(t *testing.T) {
+	lb := read(t, "testdata/2022.json")
+	var b bytes.Buffer
+	if err := lb.DeltaTable(lb.Find("alice")).WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `NAME   DAY  PART 1    PART 2    DELTA
+Alice  1    00:05:00  00:10:00  00:05:00
+Alice  2    00:03:20  00:16:40  00:13:20
+Alice  3    00:01:40  25:00:00  24:58:20
+`
+	if b.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+	}
+}
@@ -0,0 +1,97 @@
+package leaderboard
+
+import (
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Table is a report on a leaderboard, to be written as text or CSV.
+type Table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// WriteText writes t as aligned columns.
+func (t Table) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.Header, "\t"))
+	for _, r := range t.Rows {
+		fmt.Fprintln(tw, strings.Join(r, "\t"))
+	}
+	return tw.Flush()
+}
+
+// WriteCSV writes t as CSV, with the header as its first record.
+func (t Table) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write(t.Header)
+	cw.WriteAll(t.Rows)
+	return cw.Error()
+}
+
+// ScoreTable ranks the members by local score, as the site does, with their
+// stars and streaks as of now.
+func (lb *Leaderboard) ScoreTable(now time.Time) Table {
+	scores := lb.Scores()
+	members := lb.List()
+	slices.SortStableFunc(members, func(a, b *Member) int {
+		return cmp.Or(
+			cmp.Compare(scores[b.ID], scores[a.ID]),
+			cmp.Compare(b.Stars, a.Stars),
+			cmp.Compare(a.LastStar, b.LastStar),
+		)
+	})
+
+	t := Table{Header: []string{"RANK", "NAME", "SCORE", "STARS", "STREAK", "LONGEST STREAK"}}
+	for i, m := range members {
+		s := lb.Streak(m, now)
+		t.Rows = append(t.Rows, []string{
+			strconv.Itoa(i + 1), m.DisplayName(), strconv.Itoa(scores[m.ID]), strconv.Itoa(m.Stars),
+			strconv.Itoa(s.Current), strconv.Itoa(s.Longest),
+		})
+	}
+	return t
+}
+
+// TimelineTable lists when each of members got each star.
+func (lb *Leaderboard) TimelineTable(members []*Member) Table {
+	t := Table{Header: []string{"NAME", "DAY", "PART", "TIME", "SINCE UNLOCK"}}
+	for _, m := range members {
+		for _, s := range lb.Timeline(m) {
+			t.Rows = append(t.Rows, []string{
+				m.DisplayName(), strconv.Itoa(s.Day), strconv.Itoa(s.Part),
+				s.At.In(puzzleTime).Format(time.RFC3339), formatDuration(s.Elapsed),
+			})
+		}
+	}
+	return t
+}
+
+// DeltaTable lists how long each of members took over each part of the days
+// they got both stars for.
+func (lb *Leaderboard) DeltaTable(members []*Member) Table {
+	t := Table{Header: []string{"NAME", "DAY", "PART 1", "PART 2", "DELTA"}}
+	for _, m := range members {
+		for _, d := range lb.Deltas(m) {
+			t.Rows = append(t.Rows, []string{
+				m.DisplayName(), strconv.Itoa(d.Day),
+				formatDuration(d.Part1), formatDuration(d.Part2), formatDuration(d.Between()),
+			})
+		}
+	}
+	return t
+}
+
+// formatDuration formats d as hours, minutes and seconds, such as 01:02:03,
+// the way the site shows personal times.
+func formatDuration(d time.Duration) string {
+	s := int(d.Round(time.Second) / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
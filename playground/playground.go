@@ -0,0 +1,100 @@
+// Package playground solves puzzles for the in-browser playground, built for
+// WebAssembly in cmd/playground. It's kept apart from the JavaScript glue so
+// that it builds and is tested like any other package.
+package playground
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/nickshine/adventofcode2022/anim"
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+// Day describes a day for the playground's page.
+type Day struct {
+	Day           int    `json:"day"`
+	Params        string `json:"params"` // for the puzzle input, as comma separated name=value pairs
+	ExampleParams string `json:"example_params"`
+	Example       string `json:"example"`
+	Visual        bool   `json:"visual"` // whether solving draws a picture
+}
+
+// Days returns every registered day of year.
+func Days(year int) []Day {
+	var days []Day
+	for _, p := range aoc.PuzzlesYear(year) {
+		_, visual := p.New(p.Params).(anim.Recordable)
+		days = append(days, Day{
+			Day:           p.Day,
+			Params:        p.Params.String(),
+			ExampleParams: p.ExampleParams.String(),
+			Example:       p.Example,
+			Visual:        visual,
+		})
+	}
+	return days
+}
+
+// Result is the outcome of solving a part.
+type Result struct {
+	Answer  string `json:"answer,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Visual  string `json:"visual,omitempty"` // the last picture drawn while solving
+	Elapsed string `json:"elapsed,omitempty"`
+}
+
+// lastFrame is a Recorder that keeps only the latest frame.
+type lastFrame struct {
+	frame anim.Frame
+}
+
+func (l *lastFrame) Frame(f anim.Frame) {
+	l.frame = f
+}
+
+// Solve solves part of day of year for the input in, with the day's parameters
+// overridden by params, a list of name=value pairs separated by commas, as
+// Day lists them, or spaces. Only if visual is the day's last picture drawn,
+// as recording slows some days down a lot.
+func Solve(year, day, part int, in, params string, visual bool) Result {
+	puzzle, ok := aoc.LookupYear(year, day)
+	if !ok {
+		return Result{Error: fmt.Sprintf("no solution for day %d of %d", day, year)}
+	}
+
+	overrides := aoc.Params{}
+	for _, p := range strings.FieldsFunc(params, func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) {
+		if err := overrides.Set(p); err != nil {
+			return Result{Error: err.Error()}
+		}
+	}
+
+	s, err := puzzle.Load(strings.NewReader(in), puzzle.Params.Merge(overrides))
+	if err != nil {
+		return Result{Error: err.Error()}
+	}
+
+	var last lastFrame
+	if rec, ok := s.(anim.Recordable); ok && visual {
+		rec.Record(&last)
+	}
+
+	start := time.Now()
+	answer, err := aoc.SolvePart(s, part)
+	elapsed := time.Since(start)
+
+	res := Result{Elapsed: elapsed.Round(time.Microsecond).String(), Visual: strings.Join(last.frame, "\n")}
+	switch {
+	case errors.Is(err, aoc.ErrNoPart):
+		res.Error = fmt.Sprintf("day %d has no part %d", day, part)
+	case err != nil:
+		res.Error = err.Error()
+	default:
+		res.Answer = answer.String()
+	}
+	return res
+}
@@ -0,0 +1,70 @@
+package playground
+
+import (
+	"strings"
+	"testing"
+
+	_ "github.com/nickshine/adventofcode2022/all"
+	"github.com/nickshine/adventofcode2022/aoc"
+)
+
+func TestDays(t *testing.T) {
+	days := Days(aoc.DefaultYear)
+	if len(days) != 25 {
+		t.Fatalf("got %d days, want 25", len(days))
+	}
+	if days := Days(2015); len(days) != 0 {
+		t.Errorf("got %d days of 2015, want none", len(days))
+	}
+	if d := days[6]; d.Day != 7 || !d.Visual || d.Example == "" {
+		t.Errorf("day 7 = %+v, want it with its example and a visual", d)
+	}
+	if d := days[14]; d.Params == "" || d.ExampleParams == d.Params {
+		t.Errorf("day 15 = %+v, want different parameters for the example", d)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	days := Days(aoc.DefaultYear)
+	for _, tc := range []struct {
+		day, part int
+		params    string
+		answer    string
+		visual    string // a line the visual has, when asked for
+		err       string
+	}{
+		{day: 1, part: 1, answer: "24000"},
+		{day: 7, part: 1, answer: "95437", visual: "    - h.lst (file, size=62596)"},
+		{day: 10, part: 2, answer: "##  ##  ##", visual: "######      ######      ######      ####"},
+		{day: 15, part: 1, params: days[14].ExampleParams, answer: "26"},
+		{day: 25, part: 2, err: "day 25 has no part 2"},
+		{day: 1, part: 1, params: "nonsense", err: "nonsense"},
+		{day: 26, part: 1, err: "no solution for day 26 of 2022"},
+	} {
+		in := ""
+		if tc.day <= 25 {
+			in = days[tc.day-1].Example
+		}
+
+		res := Solve(aoc.DefaultYear, tc.day, tc.part, in, tc.params, tc.visual != "")
+		if tc.err != "" {
+			if !strings.Contains(res.Error, tc.err) {
+				t.Errorf("day %d part %d error = %q, want it to mention %q", tc.day, tc.part, res.Error, tc.err)
+			}
+			continue
+		}
+		if res.Error != "" || !strings.HasPrefix(res.Answer, tc.answer) {
+			t.Errorf("day %d part %d = %q, %q, want %q", tc.day, tc.part, res.Answer, res.Error, tc.answer)
+		}
+		if tc.visual != "" && !strings.Contains(res.Visual, tc.visual) {
+			t.Errorf("day %d part %d visual =\n%s\nwant a line %q", tc.day, tc.part, res.Visual, tc.visual)
+		}
+	}
+
+	if res := Solve(aoc.DefaultYear, 10, 2, days[9].Example, "", false); res.Visual != "" {
+		t.Errorf("day 10 part 2 visual =\n%s\nwant none when not asked for", res.Visual)
+	}
+	if res := Solve(aoc.DefaultYear, 1, 1, "1\n\nx\n", "", false); !strings.Contains(res.Error, "line 3, column 1") {
+		t.Errorf("bad input error = %q, want its position", res.Error)
+	}
+}
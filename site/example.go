@@ -0,0 +1,38 @@
+package site
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var tagRE = regexp.MustCompile(`<[^>]*>`)
+
+// FindExample returns the example input from the HTML of a puzzle's page: the
+// first block of code after the description says "for example", or else the
+// first block of code at all.
+func FindExample(page string) (string, bool) {
+	const open, close = "<pre><code>", "</code></pre>"
+
+	start := -1
+	if i := strings.Index(strings.ToLower(page), "for example"); i >= 0 {
+		if j := strings.Index(page[i:], open); j >= 0 {
+			start = i + j
+		}
+	}
+	if start < 0 {
+		start = strings.Index(page, open)
+	}
+	if start < 0 {
+		return "", false
+	}
+	start += len(open)
+
+	end := strings.Index(page[start:], close)
+	if end < 0 {
+		return "", false
+	}
+
+	// examples highlight parts of themselves with <em>
+	return html.UnescapeString(tagRE.ReplaceAllString(page[start:start+end], "")), true
+}
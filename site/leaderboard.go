@@ -0,0 +1,57 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// LeaderboardInterval is how long a downloaded leaderboard is reused for, as
+// the site asks that one is fetched no more than once every 15 minutes.
+const LeaderboardInterval = 15 * time.Minute
+
+// Leaderboard returns the JSON of the private leaderboard id for year, from
+// the cache if it was downloaded less than LeaderboardInterval ago.
+func (c *Client) Leaderboard(ctx context.Context, year, id int) ([]byte, error) {
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+
+	var path string
+	if c.CacheDir != "" {
+		path = filepath.Join(c.userDir(), strconv.Itoa(year), fmt.Sprintf("leaderboard-%d.json", id))
+		if fi, err := os.Stat(path); err == nil && now().Sub(fi.ModTime()) < LeaderboardInterval {
+			return os.ReadFile(path)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%d/leaderboard/private/view/%d.json", c.BaseURL, year, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	b, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if path != "" {
+		// stamped with when it was fetched, for the next call to judge its age by
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, b, 0o600); err != nil {
+			return nil, err
+		}
+		t := now()
+		if err := os.Chtimes(path, t, t); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
@@ -0,0 +1,305 @@
+// Package site talks to the Advent of Code website: it downloads puzzle
+// inputs and examples for a logged in user, keeping what it downloads in an
+// on-disk cache so that nothing is ever fetched twice, and submits answers,
+// spacing out its requests as the site asks.
+package site
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BaseURL is the address of the real site.
+const BaseURL = "https://adventofcode.com"
+
+// UserAgent identifies requests as coming from this project's tools, as the
+// site asks of automated requests.
+const UserAgent = "github.com/nickshine/adventofcode2022/cmd/aoc"
+
+// Interval is the least time between two requests to the site.
+const Interval = 5 * time.Second
+
+var (
+	// ErrNoSession is returned when there's no session cookie to send.
+	ErrNoSession = errors.New("no session cookie; set AOC_SESSION or save it in the session file")
+
+	// ErrSession is returned when the site doesn't accept the session
+	// cookie, usually because it has expired.
+	ErrSession = errors.New("the site rejected the session cookie; log in again and update it")
+
+	// ErrLocked is returned for a puzzle that isn't unlocked yet.
+	ErrLocked = errors.New("the puzzle isn't unlocked yet")
+)
+
+// Client makes requests to the site, or a stand-in for it, for one user.
+type Client struct {
+	BaseURL   string
+	Session   string // the user's session cookie
+	UserAgent string
+
+	// CacheDir is where downloads are kept, as USER/YEAR/DAY/NAME, where
+	// USER is a hash of the session, as inputs differ by user. Empty turns
+	// the cache off.
+	CacheDir string
+
+	// Interval is the least time between requests, kept across processes by
+	// the modification time of a file in CacheDir.
+	Interval time.Duration
+
+	HTTP *http.Client
+
+	mu    sync.Mutex
+	last  time.Time // when the last request was made
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+// New returns a client for the real site, sending session, caching in the
+// user's cache directory.
+func New(session string) (*Client, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		BaseURL:   BaseURL,
+		Session:   session,
+		UserAgent: UserAgent,
+		CacheDir:  filepath.Join(dir, "aoc"),
+		Interval:  Interval,
+		HTTP:      http.DefaultClient,
+	}, nil
+}
+
+// SessionFile returns the path of the file the session cookie is read from
+// when AOC_SESSION isn't set.
+func SessionFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc", "session"), nil
+}
+
+// Session returns the user's session cookie, from the AOC_SESSION environment
+// variable or else the session file.
+func Session() (string, error) {
+	if s := strings.TrimSpace(os.Getenv("AOC_SESSION")); s != "" {
+		return s, nil
+	}
+
+	path, err := SessionFile()
+	if err != nil {
+		return "", err
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("%w (%s)", ErrNoSession, path)
+	} else if err != nil {
+		return "", err
+	}
+
+	s := strings.TrimSpace(string(b))
+	if s == "" {
+		return "", fmt.Errorf("%w (%s is empty)", ErrNoSession, path)
+	}
+	return s, nil
+}
+
+// Input returns the user's puzzle input for day of year.
+func (c *Client) Input(ctx context.Context, year, day int) (string, error) {
+	return c.cached(year, day, "input.txt", func() (string, error) {
+		return c.get(ctx, fmt.Sprintf("/%d/day/%d/input", year, day))
+	})
+}
+
+// Example returns the first example input in the puzzle's description.
+func (c *Client) Example(ctx context.Context, year, day int) (string, error) {
+	return c.cached(year, day, "example.txt", func() (string, error) {
+		page, err := c.get(ctx, fmt.Sprintf("/%d/day/%d", year, day))
+		if err != nil {
+			return "", err
+		}
+
+		ex, ok := FindExample(page)
+		if !ok {
+			return "", fmt.Errorf("no example in the description of %d day %d", year, day)
+		}
+		return ex, nil
+	})
+}
+
+// Cached reports whether name, such as input.txt, is already in the cache
+// for day of year.
+func (c *Client) Cached(year, day int, name string) bool {
+	if c.CacheDir == "" {
+		return false
+	}
+
+	_, err := os.Stat(c.cachePath(year, day, name))
+	return err == nil
+}
+
+func (c *Client) cachePath(year, day int, name string) string {
+	return filepath.Join(c.userDir(), strconv.Itoa(year), strconv.Itoa(day), name)
+}
+
+// userDir returns the directory in CacheDir for the user of the session.
+func (c *Client) userDir() string {
+	sum := sha256.Sum256([]byte(c.Session))
+	return filepath.Join(c.CacheDir, hex.EncodeToString(sum[:8]))
+}
+
+// cached returns the file name for day of year from the cache, or else from
+// fetch, saving it in the cache for next time.
+func (c *Client) cached(year, day int, name string, fetch func() (string, error)) (string, error) {
+	if c.CacheDir == "" {
+		return fetch()
+	}
+
+	path := c.cachePath(year, day, name)
+	if b, err := os.ReadFile(path); err == nil {
+		return string(b), nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	s, err := fetch()
+	if err != nil {
+		return "", err
+	}
+
+	// write it whole or not at all, so a cached file is always complete
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(s), 0o600); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return "", err
+	}
+
+	return s, nil
+}
+
+// get requests path from the site, waiting its turn first, and returns the
+// body of a successful response.
+func (c *Client) get(ctx context.Context, path string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+path, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+	return string(resp), nil
+}
+
+// do sends req with the session cookie and user agent, after waiting for
+// Interval to pass since the last request, and returns the body of a
+// successful response.
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	if err := c.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+
+	client := c.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 10<<20))
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return body, nil
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%s: %w", req.URL.Path, ErrLocked)
+	case strings.Contains(string(body), "log in"):
+		return nil, ErrSession
+	default:
+		return nil, fmt.Errorf("%s: %s", req.URL.Path, resp.Status)
+	}
+}
+
+// wait blocks until Interval has passed since the last request, by this
+// process or, going by the stamp file in CacheDir, any other.
+func (c *Client) wait(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now, sleep := time.Now, sleepContext
+	if c.now != nil {
+		now, sleep = c.now, c.sleep
+	}
+
+	var stamp string
+	last := c.last
+	if c.CacheDir != "" {
+		stamp = filepath.Join(c.CacheDir, "last-request")
+		if fi, err := os.Stat(stamp); err == nil && fi.ModTime().After(last) {
+			last = fi.ModTime()
+		}
+	}
+
+	if d := last.Add(c.Interval).Sub(now()); d > 0 {
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+
+	c.last = now()
+	if stamp != "" {
+		if err := os.MkdirAll(c.CacheDir, 0o700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(stamp, nil, 0o600); err != nil {
+			return err
+		}
+		return os.Chtimes(stamp, c.last, c.last)
+	}
+	return nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
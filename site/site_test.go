@@ -0,0 +1,240 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+const example = "1000\n2000\n3000\n\n4000\n\n5000\n6000\n\n7000\n8000\n9000\n\n10000\n"
+
+// fakeSite stands in for the real site, serving the 2022 day 1 puzzle to the
+// user with the session cookie "secret", and counting requests.
+type fakeSite struct {
+	mu       sync.Mutex
+	requests int
+	agent    string
+}
+
+func (f *fakeSite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests++
+	f.agent = r.UserAgent()
+	f.mu.Unlock()
+
+	if c, err := r.Cookie("session"); err != nil || c.Value != "secret" {
+		http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/2022/day/1/input":
+		w.Write([]byte("100\n200\n"))
+	case "/2022/day/1":
+		http.ServeFile(w, r, "testdata/day1.html")
+	case "/2022/leaderboard/private/view/42.json":
+		w.Write([]byte(`{"event":"2022","owner_id":42,"members":{}}`))
+	default:
+		http.Error(w, "404 Not Found", http.StatusNotFound)
+	}
+}
+
+// fakeClock is the time as the client sees it, passing only when it sleeps.
+type fakeClock struct {
+	t     time.Time
+	slept []time.Duration
+}
+
+func (f *fakeClock) now() time.Time { return f.t }
+
+func (f *fakeClock) sleep(ctx context.Context, d time.Duration) error {
+	f.slept = append(f.slept, d)
+	f.t = f.t.Add(d)
+	return nil
+}
+
+func newTestClient(url, cache string, clock *fakeClock) *Client {
+	return &Client{
+		BaseURL:   url,
+		Session:   "secret",
+		UserAgent: "test-agent",
+		CacheDir:  cache,
+		Interval:  Interval,
+		now:       clock.now,
+		sleep:     clock.sleep,
+	}
+}
+
+func TestInput(t *testing.T) {
+	site := &fakeSite{}
+	srv := httptest.NewServer(site)
+	defer srv.Close()
+
+	cache := t.TempDir()
+	clock := &fakeClock{t: time.Now()}
+	c := newTestClient(srv.URL, cache, clock)
+
+	for i := 0; i < 2; i++ {
+		in, err := c.Input(context.Background(), 2022, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if in != "100\n200\n" {
+			t.Errorf("Input = %q, want %q", in, "100\n200\n")
+		}
+	}
+	if site.requests != 1 {
+		t.Errorf("%d requests for the same input, want 1", site.requests)
+	}
+	if site.agent != "test-agent" {
+		t.Errorf("User-Agent = %q, want test-agent", site.agent)
+	}
+	if !c.Cached(2022, 1, "input.txt") {
+		t.Errorf("input isn't cached")
+	}
+
+	// as does another process using the same cache
+	if _, err := newTestClient(srv.URL, cache, clock).Input(context.Background(), 2022, 1); err != nil || site.requests != 1 {
+		t.Errorf("Input from a new client = %v after %d requests, want the cached input", err, site.requests)
+	}
+
+	// but not by another user, whose input differs
+	other := newTestClient(srv.URL, cache, clock)
+	other.Session = "other"
+	if other.Cached(2022, 1, "input.txt") {
+		t.Errorf("input is cached for another user")
+	}
+	if _, err := other.Input(context.Background(), 2022, 1); !errors.Is(err, ErrSession) || site.requests != 2 {
+		t.Errorf("Input for another user = %v after %d requests, want it requested", err, site.requests)
+	}
+}
+
+func TestExample(t *testing.T) {
+	srv := httptest.NewServer(&fakeSite{})
+	defer srv.Close()
+
+	c := newTestClient(srv.URL, t.TempDir(), &fakeClock{t: time.Now()})
+	ex, err := c.Example(context.Background(), 2022, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ex != example {
+		t.Errorf("Example = %q, want %q", ex, example)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	site := &fakeSite{}
+	srv := httptest.NewServer(site)
+	defer srv.Close()
+
+	clock := &fakeClock{t: time.Now()}
+	c := newTestClient(srv.URL, t.TempDir(), clock)
+	if _, err := c.Input(context.Background(), 2022, 2); !errors.Is(err, ErrLocked) {
+		t.Errorf("Input of a locked day = %v, want ErrLocked", err)
+	}
+	if _, err := c.Input(context.Background(), 2022, 2); !errors.Is(err, ErrLocked) || site.requests != 2 {
+		t.Errorf("Input of a locked day again = %v after %d requests, want ErrLocked after 2, as errors aren't cached", err, site.requests)
+	}
+
+	c.Session = "expired"
+	if _, err := c.Input(context.Background(), 2022, 1); !errors.Is(err, ErrSession) {
+		t.Errorf("Input with an expired session = %v, want ErrSession", err)
+	}
+
+	c.Session = ""
+	if _, err := c.Input(context.Background(), 2022, 1); !errors.Is(err, ErrNoSession) || site.requests != 3 {
+		t.Errorf("Input with no session = %v after %d requests, want ErrNoSession without a request", err, site.requests)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	srv := httptest.NewServer(&fakeSite{})
+	defer srv.Close()
+
+	cache := t.TempDir()
+	clock := &fakeClock{t: time.Now()}
+	c := newTestClient(srv.URL, cache, clock)
+
+	c.Input(context.Background(), 2022, 1)
+	clock.t = clock.t.Add(time.Second)
+	c.Example(context.Background(), 2022, 1)
+	if len(clock.slept) != 1 || clock.slept[0] != Interval-time.Second {
+		t.Errorf("slept %v between requests, want [%s]", clock.slept, Interval-time.Second)
+	}
+
+	// another process waits its turn too
+	clock.t = clock.t.Add(2 * time.Second)
+	newTestClient(srv.URL, cache, clock).Input(context.Background(), 2022, 3)
+	if len(clock.slept) != 2 || clock.slept[1] != Interval-2*time.Second {
+		t.Errorf("slept %v, want a second sleep of %s", clock.slept, Interval-2*time.Second)
+	}
+}
+
+func TestLeaderboard(t *testing.T) {
+	site := &fakeSite{}
+	srv := httptest.NewServer(site)
+	defer srv.Close()
+
+	clock := &fakeClock{t: time.Now()}
+	c := newTestClient(srv.URL, t.TempDir(), clock)
+	for _, want := range []int{1, 1, 2} {
+		b, err := c.Leaderboard(context.Background(), 2022, 42)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) == 0 || site.requests != want {
+			t.Errorf("Leaderboard = %q after %d requests, want it after %d", b, site.requests, want)
+		}
+		clock.t = clock.t.Add(10 * time.Minute)
+	}
+}
+
+func TestFindExample(t *testing.T) {
+	page, err := os.ReadFile("testdata/day1.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ex, ok := FindExample(string(page)); !ok || ex != example {
+		t.Errorf("FindExample = %q, %v, want %q", ex, ok, example)
+	}
+
+	if ex, ok := FindExample("<p>For example, <code>x</code> alone.</p>"); ok {
+		t.Errorf("FindExample without a code block = %q, want none", ex)
+	}
+	if ex, ok := FindExample("<pre><code>a &amp; b\n</code></pre>"); !ok || ex != "a & b\n" {
+		t.Errorf("FindExample without \"for example\" = %q, %v, want the first block", ex, ok)
+	}
+}
+
+func TestSession(t *testing.T) {
+	config := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", config)
+	t.Setenv("HOME", config)
+
+	t.Setenv("AOC_SESSION", "")
+	if _, err := Session(); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Session with none set = %v, want ErrNoSession", err)
+	}
+
+	path, err := SessionFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.MkdirAll(filepath.Dir(path), 0o700)
+	os.WriteFile(path, []byte("from-file\n"), 0o600)
+	if s, err := Session(); err != nil || s != "from-file" {
+		t.Errorf("Session = %q, %v, want from-file", s, err)
+	}
+
+	t.Setenv("AOC_SESSION", "from-env")
+	if s, err := Session(); err != nil || s != "from-env" {
+		t.Errorf("Session = %q, %v, want from-env", s, err)
+	}
+}
@@ -0,0 +1,106 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is what the site made of a submitted answer.
+type Verdict int
+
+const (
+	Unknown    Verdict = iota // a response Submit doesn't recognize
+	Correct                   // the right answer
+	Wrong                     // not the right answer, with no hint
+	TooHigh                   // not the right answer, and too high
+	TooLow                    // not the right answer, and too low
+	TooSoon                   // not checked, as the last answer was too recent
+	WrongLevel                // not checked, as the part is solved or locked
+)
+
+var verdicts = [...]string{"unknown", "correct", "wrong", "too high", "too low", "too soon", "wrong level"}
+
+func (v Verdict) String() string {
+	if v < 0 || int(v) >= len(verdicts) {
+		return fmt.Sprintf("Verdict(%d)", int(v))
+	}
+	return verdicts[v]
+}
+
+// Result is the site's response to a submitted answer.
+type Result struct {
+	Verdict Verdict
+	Wait    time.Duration // how long to wait before answering again, if said
+	Message string        // the response as plain text
+}
+
+// Submit submits answer to part of day of year. Submissions are never cached.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Result, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/%d/day/%d/answer", c.BaseURL, year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := c.do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	return ParseResult(string(body)), nil
+}
+
+var (
+	articleRE = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	spaceRE   = regexp.MustCompile(`\s+`)
+	leftRE    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	minutesRE = regexp.MustCompile(`wait (one|\d+) minutes? before trying again`)
+)
+
+// ParseResult makes sense of the page the site responds to an answer with.
+func ParseResult(page string) Result {
+	msg := page
+	if m := articleRE.FindStringSubmatch(page); m != nil {
+		msg = m[1]
+	}
+	msg = html.UnescapeString(tagRE.ReplaceAllString(msg, ""))
+	msg = strings.TrimSpace(spaceRE.ReplaceAllString(msg, " "))
+
+	r := Result{Message: msg}
+	switch {
+	case strings.Contains(msg, "That's the right answer"):
+		r.Verdict = Correct
+	case strings.Contains(msg, "That's not the right answer"):
+		r.Verdict = Wrong
+		if strings.Contains(msg, "answer is too high") {
+			r.Verdict = TooHigh
+		} else if strings.Contains(msg, "answer is too low") {
+			r.Verdict = TooLow
+		}
+	case strings.Contains(msg, "You gave an answer too recently"):
+		r.Verdict = TooSoon
+	case strings.Contains(msg, "You don't seem to be solving the right level"):
+		r.Verdict = WrongLevel
+	}
+
+	if m := leftRE.FindStringSubmatch(msg); m != nil {
+		min, _ := strconv.Atoi(m[1])
+		sec, _ := strconv.Atoi(m[2])
+		r.Wait = time.Duration(min)*time.Minute + time.Duration(sec)*time.Second
+	} else if m := minutesRE.FindStringSubmatch(msg); m != nil {
+		min := 1
+		if m[1] != "one" {
+			min, _ = strconv.Atoi(m[1])
+		}
+		r.Wait = time.Duration(min) * time.Minute
+	}
+
+	return r
+}
@@ -0,0 +1,65 @@
+package site
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		file    string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		{"answer-correct.html", Correct, 0},
+		{"answer-too-high.html", TooHigh, time.Minute},
+		{"answer-wrong.html", Wrong, 5 * time.Minute},
+		{"answer-too-soon.html", TooSoon, time.Minute + 23*time.Second},
+		{"answer-wrong-level.html", WrongLevel, 0},
+	}
+
+	for _, tt := range tests {
+		page, err := os.ReadFile("testdata/" + tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r := ParseResult(string(page))
+		if r.Verdict != tt.verdict || r.Wait != tt.wait {
+			t.Errorf("%s: ParseResult = %s, wait %s, want %s, wait %s", tt.file, r.Verdict, r.Wait, tt.verdict, tt.wait)
+		}
+	}
+
+	if r := ParseResult("<html>Something else</html>"); r.Verdict != Unknown {
+		t.Errorf("ParseResult of an unknown page = %s, want unknown", r.Verdict)
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	var level, answer string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2022/day/1/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		level, answer = r.PostFormValue("level"), r.PostFormValue("answer")
+		http.ServeFile(w, r, "testdata/answer-correct.html")
+	}))
+	defer srv.Close()
+
+	c := newTestClient(srv.URL, t.TempDir(), &fakeClock{t: time.Now()})
+	r, err := c.Submit(context.Background(), 2022, 1, 2, "45000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Verdict != Correct {
+		t.Errorf("Submit = %s: %s, want correct", r.Verdict, r.Message)
+	}
+	if level != "2" || answer != "45000" {
+		t.Errorf("submitted level %q, answer %q, want 2, 45000", level, answer)
+	}
+}